@@ -6,8 +6,13 @@ import (
 )
 
 // Equal checks if values are equal
+// Values of types which can't be compared with ==, e.g. structs holding slices, are compared with reflect.DeepEqual.
 func Equal(t *testing.T, a interface{}, b interface{}) {
-	if a == b {
+	if reflect.TypeOf(a) == reflect.TypeOf(b) && a != nil && !reflect.TypeOf(a).Comparable() {
+		if reflect.DeepEqual(a, b) {
+			return
+		}
+	} else if a == b {
 		return
 	}
 	t.Errorf("Received %v (type %v), expected %v (type %v)", a, reflect.TypeOf(a), b, reflect.TypeOf(b))
@@ -0,0 +1,245 @@
+package booleans
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// location of a point relative to a polygon.
+const (
+	outside  = -1
+	boundary = 0
+	inside   = 1
+)
+
+// Clockwise takes a ring and returns true if its coordinates are in clockwise order.
+func Clockwise(ring []geometry.Point) bool {
+	sum := 0.0
+	for i := 1; i < len(ring); i++ {
+		prev := ring[i-1]
+		cur := ring[i]
+		sum += (cur.Lng - prev.Lng) * (cur.Lat + prev.Lat)
+	}
+	return sum > 0
+}
+
+// PointOnLine returns true if the point is on the line. If ignoreEndVertices is true the first and last coordinates
+// of the line are not considered part of it.
+func PointOnLine(pt geometry.Point, ln geometry.LineString, ignoreEndVertices bool) bool {
+	return pointOnLine(pt, ln.Coordinates, ignoreEndVertices)
+}
+
+func pointOnLine(pt geometry.Point, line []geometry.Point, ignoreEndVertices bool) bool {
+	if ignoreEndVertices && len(line) > 0 && (planar.SamePoint(pt, line[0]) || planar.SamePoint(pt, line[len(line)-1])) {
+		return false
+	}
+	for i := 0; i < len(line)-1; i++ {
+		if planar.OnSegment(pt, line[i], line[i+1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// toObject converts a Feature, a Geometry or a geometry type to its geometry value type.
+func toObject(t interface{}) (geometry.Object, error) {
+	switch gtp := t.(type) {
+	case *feature.Feature:
+		if gtp == nil {
+			return nil, errors.New("geometry cannot be nil")
+		}
+		o, err := gtp.ToObject()
+		if err != nil {
+			return nil, err
+		}
+		return toObject(o)
+	case feature.Feature:
+		return toObject(&gtp)
+	case *geometry.Geometry:
+		if gtp == nil {
+			return nil, errors.New("geometry cannot be nil")
+		}
+		o, err := gtp.ToObject()
+		if err != nil {
+			return nil, err
+		}
+		return toObject(o)
+	case geometry.Geometry:
+		return toObject(&gtp)
+	case *geometry.Point:
+		return *gtp, nil
+	case *geometry.MultiPoint:
+		return *gtp, nil
+	case *geometry.LineString:
+		return *gtp, nil
+	case *geometry.MultiLineString:
+		return *gtp, nil
+	case *geometry.Polygon:
+		return *gtp, nil
+	case *geometry.MultiPolygon:
+		return *gtp, nil
+	case *geometry.Collection:
+		return *gtp, nil
+	case geometry.Point, geometry.MultiPoint, geometry.LineString, geometry.MultiLineString, geometry.Polygon,
+		geometry.MultiPolygon, geometry.Collection:
+		return gtp.(geometry.Object), nil
+	}
+	return nil, errors.New("unknown geometry")
+}
+
+// toObjects converts both arguments of a predicate.
+func toObjects(t1 interface{}, t2 interface{}) (geometry.Object, geometry.Object, error) {
+	o1, err := toObject(t1)
+	if err != nil {
+		return nil, nil, err
+	}
+	o2, err := toObject(t2)
+	if err != nil {
+		return nil, nil, err
+	}
+	return o1, o2, nil
+}
+
+// inRing returns true if the point is inside the ring using the ray casting algorithm.
+func inRing(pt geometry.Point, ring []geometry.Point) bool {
+	isInside := false
+	j := len(ring) - 1
+	for i := 0; i < len(ring); i++ {
+		xi, yi := ring[i].Lng, ring[i].Lat
+		xj, yj := ring[j].Lng, ring[j].Lat
+		if (yi > pt.Lat) != (yj > pt.Lat) && pt.Lng < (xj-xi)*(pt.Lat-yi)/(yj-yi)+xi {
+			isInside = !isInside
+		}
+		j = i
+	}
+	return isInside
+}
+
+// locateInPolygon returns whether the point is inside, on the boundary or outside of the polygon.
+func locateInPolygon(pt geometry.Point, rings []geometry.LineString) int {
+	if len(rings) == 0 {
+		return outside
+	}
+	for _, r := range rings {
+		if pointOnLine(pt, r.Coordinates, false) {
+			return boundary
+		}
+	}
+	if !inRing(pt, rings[0].Coordinates) {
+		return outside
+	}
+	for _, hole := range rings[1:] {
+		if inRing(pt, hole.Coordinates) {
+			return outside
+		}
+	}
+	return inside
+}
+
+// locateInPolygons returns the location of the point relative to the union of the polygons.
+func locateInPolygons(pt geometry.Point, polygons []geometry.Polygon) int {
+	location := outside
+	for _, p := range polygons {
+		l := locateInPolygon(pt, p.Coordinates)
+		if l > location {
+			location = l
+		}
+	}
+	return location
+}
+
+// segmentsIntersect returns true if the segments ab and cd share at least a point.
+func segmentsIntersect(a geometry.Point, b geometry.Point, c geometry.Point, d geometry.Point) bool {
+	d1 := planar.Cross(c, d, a)
+	d2 := planar.Cross(c, d, b)
+	d3 := planar.Cross(a, b, c)
+	d4 := planar.Cross(a, b, d)
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	return planar.OnSegment(a, c, d) || planar.OnSegment(b, c, d) || planar.OnSegment(c, a, b) || planar.OnSegment(d, a, b)
+}
+
+// linesIntersect returns true if the lines share at least a point.
+func linesIntersect(l1 []geometry.Point, l2 []geometry.Point) bool {
+	for i := 0; i < len(l1)-1; i++ {
+		for j := 0; j < len(l2)-1; j++ {
+			if segmentsIntersect(l1[i], l1[i+1], l2[j], l2[j+1]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// splitPoints returns the points of the line together with the midpoints of the parts its segments are split into
+// by the rings. Every part lies entirely inside, on the boundary or outside of the polygon, so the location of
+// the line relative to the polygon is the location of these points.
+func splitPoints(line []geometry.Point, rings []geometry.LineString) []geometry.Point {
+	points := append([]geometry.Point{}, line...)
+	for i := 0; i < len(line)-1; i++ {
+		a, b := line[i], line[i+1]
+		params := []float64{0, 1}
+		for _, r := range rings {
+			for j := 0; j < len(r.Coordinates)-1; j++ {
+				params = append(params, splitParams(a, b, r.Coordinates[j], r.Coordinates[j+1])...)
+			}
+		}
+		sort.Float64s(params)
+		for k := 1; k < len(params); k++ {
+			if params[k]-params[k-1] <= 0 {
+				continue
+			}
+			t := (params[k] + params[k-1]) / 2
+			points = append(points, geometry.Point{Lng: a.Lng + t*(b.Lng-a.Lng), Lat: a.Lat + t*(b.Lat-a.Lat)})
+		}
+	}
+	return points
+}
+
+// splitParams returns the positions along the segment ab, as a fraction of its length, where it meets the segment cd.
+func splitParams(a geometry.Point, b geometry.Point, c geometry.Point, d geometry.Point) []float64 {
+	rx, ry := b.Lng-a.Lng, b.Lat-a.Lat
+	lengthSq := rx*rx + ry*ry
+	if lengthSq == 0 {
+		return nil
+	}
+	if p, _, ok := planar.SegmentIntersection(a, b, c, d); ok {
+		return []float64{((p.Lng-a.Lng)*rx + (p.Lat-a.Lat)*ry) / lengthSq}
+	}
+	// parallel segments only meet when they are collinear
+	var params []float64
+	for _, p := range []geometry.Point{c, d} {
+		if planar.OnSegment(p, a, b) {
+			params = append(params, ((p.Lng-a.Lng)*rx+(p.Lat-a.Lat)*ry)/lengthSq)
+		}
+	}
+	return params
+}
+
+// locateLine returns the lowest and the highest location of the points of the line relative to the polygon.
+func locateLine(line []geometry.Point, polygon geometry.Polygon) (int, int) {
+	lowest, highest := inside, outside
+	for _, p := range splitPoints(line, polygon.Coordinates) {
+		l := locateInPolygon(p, polygon.Coordinates)
+		if l < lowest {
+			lowest = l
+		}
+		if l > highest {
+			highest = l
+		}
+	}
+	return lowest, highest
+}
+
+// bboxContains returns true if bbox2 lies inside bbox1.
+func bboxContains(bbox1 []float64, bbox2 []float64) bool {
+	if bbox1 == nil || bbox2 == nil {
+		return false
+	}
+	return bbox1[0] <= bbox2[0] && bbox1[1] <= bbox2[1] && bbox1[2] >= bbox2[2] && bbox1[3] >= bbox2[3]
+}
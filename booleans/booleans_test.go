@@ -0,0 +1,168 @@
+package booleans
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/utils"
+)
+
+const fixturesDir = "../test-data/booleans"
+
+// runFixtures runs the predicate on the two features of every fixture under the true and false directories of
+// the predicate, laid out as the fixtures of the Turf boolean modules, and checks that the result matches the
+// directory.
+func runFixtures(t *testing.T, name string, predicate func(interface{}, interface{}) (bool, error)) {
+	for _, want := range []bool{true, false} {
+		dir := filepath.Join(fixturesDir, name, strconv.FormatBool(want))
+		var files []string
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() && filepath.Ext(path) == ".geojson" {
+				files = append(files, path)
+			}
+			return err
+		})
+		if err != nil {
+			t.Fatalf("cannot read fixtures: %v", err)
+		}
+		if len(files) == 0 {
+			t.Fatalf("no fixtures in %s", dir)
+		}
+		for _, path := range files {
+			rel, _ := filepath.Rel(fixturesDir, path)
+			t.Run(rel, func(t *testing.T) {
+				gjson, err := utils.LoadJSONFixture(path)
+				if err != nil {
+					t.Fatalf("cannot load fixture: %v", err)
+				}
+				fc, err := feature.CollectionFromJSON(gjson)
+				if err != nil {
+					t.Fatalf("cannot decode fixture: %v", err)
+				}
+				got, err := predicate(&fc.Features[0], &fc.Features[1])
+				if err != nil {
+					t.Errorf("%s error: %v", name, err)
+				}
+				assert.Equal(t, got, want)
+			})
+		}
+	}
+}
+
+func TestContains(t *testing.T) {
+	runFixtures(t, "contains", Contains)
+}
+
+func TestWithin(t *testing.T) {
+	// a geometry is within another geometry when the other one contains it
+	runFixtures(t, "contains", func(t1 interface{}, t2 interface{}) (bool, error) {
+		return Within(t2, t1)
+	})
+}
+
+func TestCrosses(t *testing.T) {
+	runFixtures(t, "crosses", Crosses)
+}
+
+func TestDisjoint(t *testing.T) {
+	runFixtures(t, "disjoint", Disjoint)
+}
+
+func TestEqual(t *testing.T) {
+	runFixtures(t, "equal", Equal)
+}
+
+func TestOverlap(t *testing.T) {
+	runFixtures(t, "overlap", Overlap)
+}
+
+func TestParallel(t *testing.T) {
+	runFixtures(t, "parallel", Parallel)
+}
+
+func TestUnsupportedGeometries(t *testing.T) {
+	p := geometry.Point{Lat: 1, Lng: 1}
+	poly := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lat: 0, Lng: 0}, {Lat: 0, Lng: 2}, {Lat: 2, Lng: 2}, {Lat: 0, Lng: 0}}},
+	}}
+	tests := map[string]func(interface{}, interface{}) (bool, error){
+		"contains": Contains,
+		"crosses":  Crosses,
+		"overlap":  Overlap,
+		"parallel": Parallel,
+	}
+	for name, predicate := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := predicate(p, poly)
+			if err == nil {
+				t.Errorf("%s expected an error for a Point and a Polygon", name)
+			}
+		})
+	}
+}
+
+func TestClockwise(t *testing.T) {
+	tests := map[string]struct {
+		ring []geometry.Point
+		want bool
+	}{
+		"clockwise": {
+			ring: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 1, Lat: 0}, {Lng: 0, Lat: 0}},
+			want: true,
+		},
+		"counter clockwise": {
+			ring: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}},
+			want: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, Clockwise(tt.ring), tt.want)
+		})
+	}
+}
+
+func TestPointOnLine(t *testing.T) {
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: -1, Lat: -1}, {Lng: 1, Lat: 1}, {Lng: 1.5, Lat: 2.2}}}
+	tests := map[string]struct {
+		point             geometry.Point
+		ignoreEndVertices bool
+		want              bool
+	}{
+		"on segment": {
+			point: geometry.Point{Lng: 0, Lat: 0},
+			want:  true,
+		},
+		"on vertex": {
+			point: geometry.Point{Lng: 1, Lat: 1},
+			want:  true,
+		},
+		"on end vertex": {
+			point: geometry.Point{Lng: 1.5, Lat: 2.2},
+			want:  true,
+		},
+		"ignored end vertex": {
+			point:             geometry.Point{Lng: -1, Lat: -1},
+			ignoreEndVertices: true,
+			want:              false,
+		},
+		"off line": {
+			point: geometry.Point{Lng: 0, Lat: 2},
+			want:  false,
+		},
+		"on the extension of a segment": {
+			point: geometry.Point{Lng: 2, Lat: 2},
+			want:  false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, PointOnLine(tt.point, ln, tt.ignoreEndVertices), tt.want)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package booleans
+
+import (
+	"fmt"
+
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// Contains returns true if the second geometry is completely contained by the first geometry.
+// The interiors of both geometries must intersect and the interior and boundary of the second geometry must not
+// intersect the exterior of the first.
+// The arguments can be a Feature, a Geometry or one of the geometry types.
+func Contains(t1 interface{}, t2 interface{}) (bool, error) {
+	o1, o2, err := toObjects(t1, t2)
+	if err != nil {
+		return false, err
+	}
+	result, ok := contains(o1, o2)
+	if !ok {
+		return false, fmt.Errorf("contains is not supported for %v and %v", o1.Type(), o2.Type())
+	}
+	return result, nil
+}
+
+// Within returns true if the first geometry is completely within the second geometry.
+// It is the inverse of Contains.
+func Within(t1 interface{}, t2 interface{}) (bool, error) {
+	o1, o2, err := toObjects(t1, t2)
+	if err != nil {
+		return false, err
+	}
+	result, ok := contains(o2, o1)
+	if !ok {
+		return false, fmt.Errorf("within is not supported for %v and %v", o1.Type(), o2.Type())
+	}
+	return result, nil
+}
+
+// contains returns whether o1 contains o2 and false as its second value if the pair of geometries isn't supported.
+func contains(o1 geometry.Object, o2 geometry.Object) (bool, bool) {
+	switch g1 := o1.(type) {
+	case geometry.Point:
+		switch g2 := o2.(type) {
+		case geometry.Point:
+			return planar.SamePoint(g1, g2), true
+		}
+	case geometry.MultiPoint:
+		switch g2 := o2.(type) {
+		case geometry.Point:
+			return pointInMultiPoint(g2, g1.Coordinates), true
+		case geometry.MultiPoint:
+			for _, p := range g2.Coordinates {
+				if !pointInMultiPoint(p, g1.Coordinates) {
+					return false, true
+				}
+			}
+			return true, true
+		}
+	case geometry.LineString:
+		switch g2 := o2.(type) {
+		case geometry.Point:
+			return pointOnLine(g2, g1.Coordinates, true), true
+		case geometry.MultiPoint:
+			return multiPointOnLine(g2, g1), true
+		case geometry.LineString:
+			return lineOnLine(g2, g1), true
+		}
+	case geometry.Polygon:
+		switch g2 := o2.(type) {
+		case geometry.Point:
+			return locateInPolygon(g2, g1.Coordinates) == inside, true
+		case geometry.MultiPoint:
+			return multiPointInPolygon(g2, g1), true
+		case geometry.LineString:
+			return lineInPolygon(g2, g1), true
+		case geometry.Polygon:
+			return polygonInPolygon(g2, g1), true
+		}
+	case geometry.MultiPolygon:
+		// a geometry is contained by a MultiPolygon when it is contained by one of its polygons
+		switch o2.(type) {
+		case geometry.Point, geometry.MultiPoint, geometry.LineString, geometry.Polygon:
+			for _, p := range g1.Coordinates {
+				if result, _ := contains(p, o2); result {
+					return true, true
+				}
+			}
+			return false, true
+		}
+	}
+	return false, false
+}
+
+func pointInMultiPoint(pt geometry.Point, points []geometry.Point) bool {
+	for _, p := range points {
+		if planar.SamePoint(pt, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// multiPointOnLine returns true if all the points are on the line and at least one of them isn't an end vertex.
+func multiPointOnLine(mp geometry.MultiPoint, ln geometry.LineString) bool {
+	foundInterior := false
+	for _, p := range mp.Coordinates {
+		if !pointOnLine(p, ln.Coordinates, false) {
+			return false
+		}
+		if !foundInterior && pointOnLine(p, ln.Coordinates, true) {
+			foundInterior = true
+		}
+	}
+	return foundInterior
+}
+
+// lineOnLine returns true if the first line lies on the second one.
+func lineOnLine(ln geometry.LineString, on geometry.LineString) bool {
+	if !bboxContains(on.BBox(), ln.BBox()) {
+		return false
+	}
+	for _, p := range splitPoints(ln.Coordinates, []geometry.LineString{on}) {
+		if !pointOnLine(p, on.Coordinates, false) {
+			return false
+		}
+	}
+	return true
+}
+
+// multiPointInPolygon returns true if none of the points is outside the polygon and at least one of them is inside.
+func multiPointInPolygon(mp geometry.MultiPoint, poly geometry.Polygon) bool {
+	foundInside := false
+	for _, p := range mp.Coordinates {
+		l := locateInPolygon(p, poly.Coordinates)
+		if l == outside {
+			return false
+		}
+		if l == inside {
+			foundInside = true
+		}
+	}
+	return foundInside
+}
+
+// lineInPolygon returns true if no part of the line is outside the polygon and a part of it is inside.
+func lineInPolygon(ln geometry.LineString, poly geometry.Polygon) bool {
+	if len(poly.Coordinates) == 0 || !bboxContains(poly.BBox(), ln.BBox()) {
+		return false
+	}
+	lowest, highest := locateLine(ln.Coordinates, poly)
+	return lowest >= boundary && highest == inside
+}
+
+// polygonInPolygon returns true if the outer ring of the first polygon is inside or on the boundary of the second
+// polygon and none of the holes of the second polygon is inside the first one.
+func polygonInPolygon(poly geometry.Polygon, in geometry.Polygon) bool {
+	if len(poly.Coordinates) == 0 || len(in.Coordinates) == 0 || !bboxContains(in.BBox(), poly.BBox()) {
+		return false
+	}
+	lowest, _ := locateLine(poly.Coordinates[0].Coordinates, in)
+	if lowest == outside {
+		return false
+	}
+	for _, hole := range in.Coordinates[1:] {
+		_, highest := locateLine(hole.Coordinates, poly)
+		if highest == inside {
+			return false
+		}
+	}
+	return true
+}
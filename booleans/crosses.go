@@ -0,0 +1,100 @@
+package booleans
+
+import (
+	"fmt"
+
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// Crosses returns true if the intersection of the geometries has a dimension lower than the maximum dimension of
+// the two geometries and it is part of the interiors of both of them.
+// It supports the MultiPoint/LineString, MultiPoint/Polygon, LineString/LineString and LineString/Polygon pairs in
+// either order.
+func Crosses(t1 interface{}, t2 interface{}) (bool, error) {
+	o1, o2, err := toObjects(t1, t2)
+	if err != nil {
+		return false, err
+	}
+
+	switch g1 := o1.(type) {
+	case geometry.MultiPoint:
+		switch g2 := o2.(type) {
+		case geometry.LineString:
+			return multiPointCrossesLine(g1, g2), nil
+		case geometry.Polygon:
+			return multiPointCrossesPolygon(g1, g2), nil
+		}
+	case geometry.LineString:
+		switch g2 := o2.(type) {
+		case geometry.MultiPoint:
+			return multiPointCrossesLine(g2, g1), nil
+		case geometry.LineString:
+			return linesCross(g1, g2), nil
+		case geometry.Polygon:
+			return lineCrossesPolygon(g1, g2), nil
+		}
+	case geometry.Polygon:
+		switch g2 := o2.(type) {
+		case geometry.MultiPoint:
+			return multiPointCrossesPolygon(g2, g1), nil
+		case geometry.LineString:
+			return lineCrossesPolygon(g2, g1), nil
+		}
+	}
+	return false, fmt.Errorf("crosses is not supported for %v and %v", o1.Type(), o2.Type())
+}
+
+// multiPointCrossesLine returns true if some of the points are on the interior of the line and some are not on the line.
+func multiPointCrossesLine(mp geometry.MultiPoint, ln geometry.LineString) bool {
+	foundInterior, foundExterior := false, false
+	for _, p := range mp.Coordinates {
+		if pointOnLine(p, ln.Coordinates, true) {
+			foundInterior = true
+		} else if !pointOnLine(p, ln.Coordinates, false) {
+			foundExterior = true
+		}
+	}
+	return foundInterior && foundExterior
+}
+
+// multiPointCrossesPolygon returns true if some of the points are inside the polygon and some are outside.
+func multiPointCrossesPolygon(mp geometry.MultiPoint, poly geometry.Polygon) bool {
+	foundInside, foundOutside := false, false
+	for _, p := range mp.Coordinates {
+		switch locateInPolygon(p, poly.Coordinates) {
+		case inside:
+			foundInside = true
+		case outside:
+			foundOutside = true
+		}
+	}
+	return foundInside && foundOutside
+}
+
+// linesCross returns true if the lines intersect at a point which isn't an end of either line.
+func linesCross(l1 geometry.LineString, l2 geometry.LineString) bool {
+	c1, c2 := l1.Coordinates, l2.Coordinates
+	if len(c1) < 2 || len(c2) < 2 {
+		return false
+	}
+	for i := 0; i < len(c1)-1; i++ {
+		for j := 0; j < len(c2)-1; j++ {
+			p, _, ok := planar.SegmentIntersection(c1[i], c1[i+1], c2[j], c2[j+1])
+			if !ok {
+				continue
+			}
+			if planar.SamePoint(p, c1[0]) || planar.SamePoint(p, c1[len(c1)-1]) || planar.SamePoint(p, c2[0]) || planar.SamePoint(p, c2[len(c2)-1]) {
+				continue
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// lineCrossesPolygon returns true if a part of the line is inside the polygon and another part is outside.
+func lineCrossesPolygon(ln geometry.LineString, poly geometry.Polygon) bool {
+	lowest, highest := locateLine(ln.Coordinates, poly)
+	return lowest == outside && highest == inside
+}
@@ -0,0 +1,121 @@
+package booleans
+
+import (
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// Disjoint returns true if the intersection of the geometries is empty.
+// Multi geometries and GeometryCollections are disjoint when all their members are disjoint.
+func Disjoint(t1 interface{}, t2 interface{}) (bool, error) {
+	o1, o2, err := toObjects(t1, t2)
+	if err != nil {
+		return false, err
+	}
+	parts1, err := flatten(o1)
+	if err != nil {
+		return false, err
+	}
+	parts2, err := flatten(o2)
+	if err != nil {
+		return false, err
+	}
+
+	for _, p1 := range parts1 {
+		for _, p2 := range parts2 {
+			if intersects(p1, p2) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// flatten splits the geometry into Points, LineStrings and Polygons.
+func flatten(o geometry.Object) ([]geometry.Object, error) {
+	var parts []geometry.Object
+	switch gtp := o.(type) {
+	case geometry.Point, geometry.LineString, geometry.Polygon:
+		parts = append(parts, gtp)
+	case geometry.MultiPoint:
+		for _, p := range gtp.Coordinates {
+			parts = append(parts, p)
+		}
+	case geometry.MultiLineString:
+		for _, l := range gtp.Coordinates {
+			parts = append(parts, l)
+		}
+	case geometry.MultiPolygon:
+		for _, p := range gtp.Coordinates {
+			parts = append(parts, p)
+		}
+	case geometry.Collection:
+		objects, err := gtp.Objects()
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range objects {
+			m, err := toObject(member)
+			if err != nil {
+				return nil, err
+			}
+			p, err := flatten(m)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, p...)
+		}
+	}
+	return parts, nil
+}
+
+// intersects returns true if two Points, LineStrings or Polygons share at least a point.
+func intersects(o1 geometry.Object, o2 geometry.Object) bool {
+	switch g1 := o1.(type) {
+	case geometry.Point:
+		switch g2 := o2.(type) {
+		case geometry.Point:
+			return planar.SamePoint(g1, g2)
+		case geometry.LineString:
+			return pointOnLine(g1, g2.Coordinates, false)
+		case geometry.Polygon:
+			return locateInPolygon(g1, g2.Coordinates) != outside
+		}
+	case geometry.LineString:
+		switch g2 := o2.(type) {
+		case geometry.Point:
+			return intersects(g2, g1)
+		case geometry.LineString:
+			return linesIntersect(g1.Coordinates, g2.Coordinates)
+		case geometry.Polygon:
+			return lineIntersectsPolygon(g1.Coordinates, g2)
+		}
+	case geometry.Polygon:
+		switch g2 := o2.(type) {
+		case geometry.Point, geometry.LineString:
+			return intersects(g2, g1)
+		case geometry.Polygon:
+			if len(g2.Coordinates) == 0 || len(g1.Coordinates) == 0 {
+				return false
+			}
+			return lineIntersectsPolygon(g1.Coordinates[0].Coordinates, g2) ||
+				lineIntersectsPolygon(g2.Coordinates[0].Coordinates, g1)
+		}
+	}
+	return false
+}
+
+// lineIntersectsPolygon returns true if a point of the line is inside the polygon or the line meets its boundary.
+func lineIntersectsPolygon(line []geometry.Point, poly geometry.Polygon) bool {
+	for _, r := range poly.Coordinates {
+		if linesIntersect(line, r.Coordinates) {
+			return true
+		}
+	}
+	for _, p := range line {
+		if locateInPolygon(p, poly.Coordinates) != outside {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package booleans
+
+import (
+	"math"
+
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// precision is the number of decimal digits Equal compares the coordinates to.
+const precision = 6
+
+// Equal returns true if the geometries are of the same type and have the same coordinates, compared to 6 decimal digits.
+// LineStrings are equal when their coordinates are reversed and the rings of Polygons are equal when they start from
+// a different coordinate or have a different orientation. The members of multi geometries can be in any order.
+func Equal(t1 interface{}, t2 interface{}) (bool, error) {
+	o1, o2, err := toObjects(t1, t2)
+	if err != nil {
+		return false, err
+	}
+	return equal(o1, o2)
+}
+
+func equal(o1 geometry.Object, o2 geometry.Object) (bool, error) {
+	if o1.Type() != o2.Type() {
+		return false, nil
+	}
+
+	switch g1 := o1.(type) {
+	case geometry.Point:
+		return equalPoints(g1, o2.(geometry.Point)), nil
+	case geometry.MultiPoint:
+		g2 := o2.(geometry.MultiPoint)
+		return equalMembers(len(g1.Coordinates), len(g2.Coordinates), func(i, j int) bool {
+			return equalPoints(g1.Coordinates[i], g2.Coordinates[j])
+		}), nil
+	case geometry.LineString:
+		return equalLines(g1.Coordinates, o2.(geometry.LineString).Coordinates), nil
+	case geometry.MultiLineString:
+		g2 := o2.(geometry.MultiLineString)
+		return equalMembers(len(g1.Coordinates), len(g2.Coordinates), func(i, j int) bool {
+			return equalLines(g1.Coordinates[i].Coordinates, g2.Coordinates[j].Coordinates)
+		}), nil
+	case geometry.Polygon:
+		return equalPolygons(g1, o2.(geometry.Polygon)), nil
+	case geometry.MultiPolygon:
+		g2 := o2.(geometry.MultiPolygon)
+		return equalMembers(len(g1.Coordinates), len(g2.Coordinates), func(i, j int) bool {
+			return equalPolygons(g1.Coordinates[i], g2.Coordinates[j])
+		}), nil
+	case geometry.Collection:
+		members1, err := g1.Objects()
+		if err != nil {
+			return false, err
+		}
+		members2, err := o2.(geometry.Collection).Objects()
+		if err != nil {
+			return false, err
+		}
+		if len(members1) != len(members2) {
+			return false, nil
+		}
+		for i := range members1 {
+			m1, m2, err := toObjects(members1[i], members2[i])
+			if err != nil {
+				return false, err
+			}
+			eq, err := equal(m1, m2)
+			if err != nil || !eq {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// equalMembers returns true if every member of the first geometry is equal to a different member of the second one.
+func equalMembers(n1 int, n2 int, eq func(i, j int) bool) bool {
+	if n1 != n2 {
+		return false
+	}
+	used := make([]bool, n2)
+	for i := 0; i < n1; i++ {
+		found := false
+		for j := 0; j < n2; j++ {
+			if !used[j] && eq(i, j) {
+				used[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func equalPoints(p1 geometry.Point, p2 geometry.Point) bool {
+	if round(p1.Lat) != round(p2.Lat) || round(p1.Lng) != round(p2.Lng) {
+		return false
+	}
+	if (p1.Alt == nil) != (p2.Alt == nil) || (p1.Alt != nil && round(*p1.Alt) != round(*p2.Alt)) {
+		return false
+	}
+	return true
+}
+
+func equalLines(l1 []geometry.Point, l2 []geometry.Point) bool {
+	if len(l1) != len(l2) {
+		return false
+	}
+	forward, backward := true, true
+	for i := range l1 {
+		forward = forward && equalPoints(l1[i], l2[i])
+		backward = backward && equalPoints(l1[i], l2[len(l2)-1-i])
+	}
+	return forward || backward
+}
+
+func equalPolygons(p1 geometry.Polygon, p2 geometry.Polygon) bool {
+	if len(p1.Coordinates) != len(p2.Coordinates) {
+		return false
+	}
+	for i := range p1.Coordinates {
+		if !equalRings(p1.Coordinates[i].Coordinates, p2.Coordinates[i].Coordinates) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalRings returns true if the closed rings have the same coordinates starting from any of them in either direction.
+func equalRings(r1 []geometry.Point, r2 []geometry.Point) bool {
+	if len(r1) != len(r2) {
+		return false
+	}
+	if len(r1) < 2 {
+		return equalLines(r1, r2)
+	}
+	// compare without the closing coordinate
+	n := len(r1) - 1
+	for offset := 0; offset < n; offset++ {
+		forward, backward := true, true
+		for i := 0; i < n; i++ {
+			forward = forward && equalPoints(r1[i], r2[(offset+i)%n])
+			backward = backward && equalPoints(r1[i], r2[(offset-i+n)%n])
+		}
+		if forward || backward {
+			return true
+		}
+	}
+	return false
+}
+
+func round(v float64) float64 {
+	p := math.Pow(10, precision)
+	return math.Round(v*p) / p
+}
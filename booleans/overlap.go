@@ -0,0 +1,119 @@
+package booleans
+
+import (
+	"fmt"
+
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// Overlap returns true if the geometries have the same dimension, their interiors intersect and each of them has
+// a part which isn't shared with the other.
+// It supports MultiPoint pairs, LineString and MultiLineString pairs and Polygon and MultiPolygon pairs.
+func Overlap(t1 interface{}, t2 interface{}) (bool, error) {
+	o1, o2, err := toObjects(t1, t2)
+	if err != nil {
+		return false, err
+	}
+
+	switch g1 := o1.(type) {
+	case geometry.MultiPoint:
+		if g2, ok := o2.(geometry.MultiPoint); ok {
+			return multiPointsOverlap(g1, g2), nil
+		}
+	case geometry.LineString, geometry.MultiLineString:
+		lines2, ok := lines(o2)
+		if ok {
+			lines1, _ := lines(g1)
+			return linesOverlap(lines1, lines2) && linesOverlap(lines2, lines1), nil
+		}
+	case geometry.Polygon, geometry.MultiPolygon:
+		polygons2, ok := polygons(o2)
+		if ok {
+			polygons1, _ := polygons(g1)
+			return boundaryInside(polygons1, polygons2) && boundaryInside(polygons2, polygons1), nil
+		}
+	}
+	return false, fmt.Errorf("overlap is not supported for %v and %v", o1.Type(), o2.Type())
+}
+
+func lines(o geometry.Object) ([]geometry.LineString, bool) {
+	switch gtp := o.(type) {
+	case geometry.LineString:
+		return []geometry.LineString{gtp}, true
+	case geometry.MultiLineString:
+		return gtp.Coordinates, true
+	}
+	return nil, false
+}
+
+func polygons(o geometry.Object) ([]geometry.Polygon, bool) {
+	switch gtp := o.(type) {
+	case geometry.Polygon:
+		return []geometry.Polygon{gtp}, true
+	case geometry.MultiPolygon:
+		return gtp.Coordinates, true
+	}
+	return nil, false
+}
+
+// multiPointsOverlap returns true if the MultiPoints share a point and each of them has a point the other doesn't have.
+func multiPointsOverlap(mp1 geometry.MultiPoint, mp2 geometry.MultiPoint) bool {
+	shared, only1, only2 := false, false, false
+	for _, p := range mp1.Coordinates {
+		if pointInMultiPoint(p, mp2.Coordinates) {
+			shared = true
+		} else {
+			only1 = true
+		}
+	}
+	for _, p := range mp2.Coordinates {
+		if !pointInMultiPoint(p, mp1.Coordinates) {
+			only2 = true
+		}
+	}
+	return shared && only1 && only2
+}
+
+// linesOverlap returns true if a part of the first lines lies on the second lines and another part doesn't.
+func linesOverlap(l1 []geometry.LineString, l2 []geometry.LineString) bool {
+	shared, notShared := false, false
+	for _, l := range l1 {
+		points := splitPoints(l.Coordinates, l2)
+		// the points of the line come first, only the midpoints of the parts tell whether a part is shared
+		for _, p := range points[len(l.Coordinates):] {
+			if onLines(p, l2) {
+				shared = true
+			} else {
+				notShared = true
+			}
+		}
+	}
+	return shared && notShared
+}
+
+func onLines(pt geometry.Point, lines []geometry.LineString) bool {
+	for _, l := range lines {
+		if pointOnLine(pt, l.Coordinates, false) {
+			return true
+		}
+	}
+	return false
+}
+
+// boundaryInside returns true if a part of the boundary of the first polygons is inside the second polygons.
+func boundaryInside(p1 []geometry.Polygon, p2 []geometry.Polygon) bool {
+	var rings []geometry.LineString
+	for _, p := range p2 {
+		rings = append(rings, p.Coordinates...)
+	}
+	for _, p := range p1 {
+		for _, r := range p.Coordinates {
+			for _, pt := range splitPoints(r.Coordinates, rings) {
+				if locateInPolygons(pt, p2) == inside {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
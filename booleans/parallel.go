@@ -0,0 +1,39 @@
+package booleans
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// Parallel returns true if each segment of the first line is parallel to the segment of the second line at the same
+// position. The direction of the segments is their rhumb bearing.
+func Parallel(t1 interface{}, t2 interface{}) (bool, error) {
+	o1, o2, err := toObjects(t1, t2)
+	if err != nil {
+		return false, err
+	}
+	l1, ok1 := o1.(geometry.LineString)
+	l2, ok2 := o2.(geometry.LineString)
+	if !ok1 || !ok2 {
+		return false, errors.New("parallel is only supported for LineStrings")
+	}
+
+	c1, c2 := l1.Coordinates, l2.Coordinates
+	for i := 0; i < len(c1)-1 && i < len(c2)-1; i++ {
+		if !parallelSegments(c1[i], c1[i+1], c2[i], c2[i+1]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// epsilon is the difference in degrees under which two bearings are considered the same.
+const epsilon = 1e-9
+
+func parallelSegments(a1 geometry.Point, b1 geometry.Point, a2 geometry.Point, b2 geometry.Point) bool {
+	diff := math.Mod(math.Abs(measurement.RhumbBearing(a1, b1)-measurement.RhumbBearing(a2, b2)), 180)
+	return diff < epsilon || 180-diff < epsilon
+}
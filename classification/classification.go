@@ -1,9 +1,13 @@
 package classification
 
 import (
+	"errors"
 	"math"
 
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/geojson/feature"
 	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/index"
 	"github.com/tomchavakis/turf-go/measurement"
 )
 
@@ -29,3 +33,107 @@ func NearestPoint(refPoint geometry.Point, points []geometry.Point, units string
 
 	return &p, nil
 }
+
+// NearestPointWithIndex is NearestPoint using an index of the points created with index.FromPoints,
+// which avoids measuring the distance to every point.
+func NearestPointWithIndex(refPoint geometry.Point, points []geometry.Point, idx *index.Index) (*geometry.Point, error) {
+	if len(points) == 0 {
+		return &refPoint, nil
+	}
+
+	nearest, err := idx.GeoNeighbors(refPoint, 1, 0, constants.UnitDefault)
+	if err != nil {
+		return nil, err
+	}
+	if len(nearest) == 0 || nearest[0] >= len(points) {
+		return nil, errors.New("the index doesn't match the points")
+	}
+
+	p := points[nearest[0]]
+	return &p, nil
+}
+
+// NearestPoints takes a reference point and a list of points and returns the k points closest to the reference,
+// ordered by distance. Every point is returned as a feature with the featureIndex property holding its position in
+// the list and the distanceToPoint property holding its distance from the reference in the given units.
+func NearestPoints(refPoint geometry.Point, points []geometry.Point, k int, units string) (*feature.Collection, error) {
+	if k <= 0 {
+		return nil, errors.New("k must be greater than 0")
+	}
+	return nearestPoints(refPoint, points, k, -1, units)
+}
+
+// PointsWithinRadius takes a reference point and a list of points and returns the points within the radius from
+// the reference, ordered by distance. The points are returned as features with the same properties as NearestPoints.
+func PointsWithinRadius(refPoint geometry.Point, points []geometry.Point, radius float64, units string) (*feature.Collection, error) {
+	if radius < 0 {
+		return nil, errors.New("radius cannot be negative")
+	}
+	return nearestPoints(refPoint, points, 0, radius, units)
+}
+
+// NearestPointsWithinRadius takes a reference point and a list of points and returns the k points closest to the
+// reference which are within the radius from it, ordered by distance. The points are returned as features with the
+// same properties as NearestPoints.
+func NearestPointsWithinRadius(refPoint geometry.Point, points []geometry.Point, k int, radius float64, units string) (*feature.Collection, error) {
+	if k <= 0 {
+		return nil, errors.New("k must be greater than 0")
+	}
+	if radius < 0 {
+		return nil, errors.New("radius cannot be negative")
+	}
+	return nearestPoints(refPoint, points, k, radius, units)
+}
+
+// NearestPointsWithIndex is NearestPointsWithinRadius using an index of the points created with index.FromPoints,
+// so that the index can be reused for many reference points. A k which isn't positive or a negative radius means
+// no limit.
+func NearestPointsWithIndex(refPoint geometry.Point, points []geometry.Point, k int, radius float64, units string, idx *index.Index) (*feature.Collection, error) {
+	maxDistance := radius
+	if radius == 0 {
+		// the index has no limit for a distance of 0, the coincident points are kept below
+		maxDistance = -1
+	}
+	nearest, err := idx.GeoNeighbors(refPoint, k, maxDistance, units)
+	if err != nil {
+		return nil, err
+	}
+
+	features := make([]feature.Feature, 0, len(nearest))
+	for _, i := range nearest {
+		if i >= len(points) {
+			return nil, errors.New("the index doesn't match the points")
+		}
+		dist, err := measurement.PointDistance(refPoint, points[i], units)
+		if err != nil {
+			return nil, err
+		}
+		if radius >= 0 && dist > radius {
+			// the neighbours are ordered by distance
+			break
+		}
+		g, err := geometry.NewGeometry(points[i])
+		if err != nil {
+			return nil, err
+		}
+		properties := map[string]interface{}{
+			"featureIndex":    i,
+			"distanceToPoint": dist,
+		}
+		f, err := feature.New(*g, nil, properties, feature.ID{})
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// nearestPoints indexes the points and returns the k points closest to the reference within the radius.
+func nearestPoints(refPoint geometry.Point, points []geometry.Point, k int, radius float64, units string) (*feature.Collection, error) {
+	idx, err := index.FromPoints(points, index.DefaultNodeSize)
+	if err != nil {
+		return nil, err
+	}
+	return NearestPointsWithIndex(refPoint, points, k, radius, units, idx)
+}
@@ -1,10 +1,16 @@
 package classification
 
 import (
+	"math/rand"
+	"reflect"
+	"sort"
 	"testing"
 
+	"github.com/tomchavakis/turf-go/assert"
 	"github.com/tomchavakis/turf-go/constants"
 	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/index"
+	"github.com/tomchavakis/turf-go/measurement"
 )
 
 func TestNearestPoint(t *testing.T) {
@@ -23,7 +29,205 @@ func TestNearestPoint(t *testing.T) {
 	if err != nil {
 		t.Errorf("nearest point error: %v", err)
 	}
-	if np != nil && *np != p3 {
-		t.Errorf("nearestPoint = %f; want %f", np, p3)
+	if np != nil && !reflect.DeepEqual(*np, p3) {
+		t.Errorf("nearestPoint = %v; want %v", np, p3)
+	}
+}
+
+func TestNearestPointWithIndex(t *testing.T) {
+	points := []geometry.Point{
+		{Lng: -75.33, Lat: 39.44},
+		{Lng: -75.33, Lat: 39.45},
+		{Lng: -75.31, Lat: 39.46},
+		{Lng: -75.30, Lat: 39.46},
+	}
+	idx, err := index.FromPoints(points, 0)
+	if err != nil {
+		t.Fatalf("FromPoints error: %v", err)
+	}
+
+	refPoint := geometry.Point{Lat: 39.50, Lng: -75.33}
+	np, err := NearestPointWithIndex(refPoint, points, idx)
+	if err != nil {
+		t.Errorf("nearest point error: %v", err)
+	}
+	if np != nil && !reflect.DeepEqual(*np, points[2]) {
+		t.Errorf("nearestPoint = %v; want %v", np, points[2])
+	}
+}
+
+func TestNearestPoints(t *testing.T) {
+	points := []geometry.Point{
+		{Lng: -75.33, Lat: 39.44},
+		{Lng: -75.33, Lat: 39.45},
+		{Lng: -75.31, Lat: 39.46},
+		{Lng: -75.30, Lat: 39.46},
+	}
+	refPoint := geometry.Point{Lat: 39.50, Lng: -75.33}
+
+	tests := map[string]struct {
+		k       int
+		want    []int
+		wantErr bool
+	}{
+		"nearest two": {
+			k:    2,
+			want: []int{2, 3},
+		},
+		"more than the points": {
+			k:    10,
+			want: []int{2, 3, 1, 0},
+		},
+		"invalid k": {
+			k:       0,
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fc, err := NearestPoints(refPoint, points, tt.k, constants.UnitKilometers)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NearestPoints error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			assert.Equal(t, len(fc.Features), len(tt.want))
+			for i, f := range fc.Features {
+				assert.Equal(t, f.Properties["featureIndex"], tt.want[i])
+				want, _ := measurement.PointDistance(refPoint, points[tt.want[i]], constants.UnitKilometers)
+				assert.Equal(t, f.Properties["distanceToPoint"], want)
+				p, err := f.ToPoint()
+				if err != nil {
+					t.Errorf("ToPoint error: %v", err)
+				}
+				assert.Equal(t, *p, points[tt.want[i]])
+			}
+		})
+	}
+}
+
+func TestPointsWithinRadius(t *testing.T) {
+	points := []geometry.Point{
+		{Lng: 23.7275, Lat: 37.9838},
+		{Lng: 23.7500, Lat: 37.9838},
+		{Lng: 23.7275, Lat: 38.0500},
+		{Lng: 23.7300, Lat: 37.9850},
+	}
+	refPoint := geometry.Point{Lng: 23.7275, Lat: 37.9838}
+
+	fc, err := PointsWithinRadius(refPoint, points, 3, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("PointsWithinRadius error: %v", err)
+	}
+	assert.Equal(t, len(fc.Features), 3)
+	for i, want := range []int{0, 3, 1} {
+		assert.Equal(t, fc.Features[i].Properties["featureIndex"], want)
+		if fc.Features[i].Properties["distanceToPoint"].(float64) > 3 {
+			t.Errorf("PointsWithinRadius returned a point outside the radius")
+		}
+	}
+
+	_, err = PointsWithinRadius(refPoint, points, -1, constants.UnitKilometers)
+	if err == nil {
+		t.Errorf("PointsWithinRadius expected an error for a negative radius")
+	}
+}
+
+func TestNearestPointsWithinRadius(t *testing.T) {
+	// couriers around a dispatch point, the nearest 5 within 3 km
+	refPoint := geometry.Point{Lng: 23.7275, Lat: 37.9838}
+	var points []geometry.Point
+	for i := 0; i < 8; i++ {
+		points = append(points, geometry.Point{Lng: refPoint.Lng + float64(i)*0.005, Lat: refPoint.Lat})
+	}
+
+	tests := map[string]struct {
+		k       int
+		radius  float64
+		want    []int
+		wantErr bool
+	}{
+		"limited by k": {
+			k:      5,
+			radius: 3,
+			want:   []int{0, 1, 2, 3, 4},
+		},
+		"limited by radius": {
+			k:      5,
+			radius: 1,
+			want:   []int{0, 1, 2},
+		},
+		"coincident points only": {
+			k:    5,
+			want: []int{0},
+		},
+		"invalid k": {
+			k:       0,
+			radius:  3,
+			wantErr: true,
+		},
+		"invalid radius": {
+			k:       5,
+			radius:  -1,
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fc, err := NearestPointsWithinRadius(refPoint, points, tt.k, tt.radius, constants.UnitKilometers)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NearestPointsWithinRadius error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			assert.Equal(t, len(fc.Features), len(tt.want))
+			for i, f := range fc.Features {
+				assert.Equal(t, f.Properties["featureIndex"], tt.want[i])
+			}
+		})
+	}
+}
+
+func TestNearestPointsWithIndex(t *testing.T) {
+	// the indexed queries match the distances to every point
+	r := rand.New(rand.NewSource(1))
+	var points []geometry.Point
+	for i := 0; i < 1000; i++ {
+		points = append(points, geometry.Point{Lng: r.Float64()*2 - 1, Lat: r.Float64()*2 - 1})
+	}
+	idx, err := index.FromPoints(points, 0)
+	if err != nil {
+		t.Fatalf("FromPoints error: %v", err)
+	}
+
+	for q := 0; q < 20; q++ {
+		refPoint := geometry.Point{Lng: r.Float64()*2 - 1, Lat: r.Float64()*2 - 1}
+		fc, err := NearestPointsWithIndex(refPoint, points, 10, 50, constants.UnitKilometers, idx)
+		if err != nil {
+			t.Fatalf("NearestPointsWithIndex error: %v", err)
+		}
+
+		var want []float64
+		for _, p := range points {
+			d, _ := measurement.PointDistance(refPoint, p, constants.UnitKilometers)
+			if d <= 50 {
+				want = append(want, d)
+			}
+		}
+		sort.Float64s(want)
+		if len(want) > 10 {
+			want = want[:10]
+		}
+		assert.Equal(t, len(fc.Features), len(want))
+		for i, f := range fc.Features {
+			assert.Equal(t, f.Properties["distanceToPoint"], want[i])
+		}
+	}
+
+	_, err = NearestPointsWithIndex(geometry.Point{}, points[:10], 0, -1, constants.UnitKilometers, idx)
+	if err == nil {
+		t.Errorf("NearestPointsWithIndex expected an error for an index of other points")
 	}
 }
@@ -31,4 +31,8 @@ const (
 	UnitDefault = "kilometres"
 	// EarthRadius is the radius of the earch in km
 	EarthRadius = 6371008.8
+	// WGS84SemiMajorAxis is the equatorial radius of the WGS84 ellipsoid in meters
+	WGS84SemiMajorAxis = 6378137.0
+	// WGS84Flattening is the flattening of the WGS84 ellipsoid
+	WGS84Flattening = 1 / 298.257223563
 )
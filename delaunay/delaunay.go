@@ -0,0 +1,352 @@
+package delaunay
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// Triangulate returns the Delaunay triangulation of the points, in the plane of their longitudes and latitudes, as
+// the indexes in points of the vertices of every triangle, counterclockwise.
+// The triangulation is built with a sweep hull: the points are added in the order of their distance from a seed
+// triangle, each one joined to the edges of the convex hull it sees, and the edges are flipped until every triangle
+// has an empty circumcircle. Repeated points are triangulated once, with the index of their first occurrence.
+// Collinear points have no triangle.
+func Triangulate(points []geometry.Point) ([][3]int, error) {
+	if len(points) < 3 {
+		return nil, errors.New("a triangulation needs at least 3 points")
+	}
+
+	var ids []int
+	seen := map[[2]float64]bool{}
+	for i, p := range points {
+		k := [2]float64{p.Lng, p.Lat}
+		if !seen[k] {
+			seen[k] = true
+			ids = append(ids, i)
+		}
+	}
+
+	s := &sweep{points: points}
+	if !s.seed(ids) {
+		return nil, nil
+	}
+	for _, i := range s.ids {
+		s.add(i)
+	}
+
+	triangles := make([][3]int, 0, len(s.triangles)/3)
+	for t := 0; t < len(s.triangles); t += 3 {
+		triangles = append(triangles, [3]int{s.triangles[t], s.triangles[t+1], s.triangles[t+2]})
+	}
+	return triangles, nil
+}
+
+// sweep holds the triangles as half edges, the half edge e going from the vertex triangles[e] to the next vertex of
+// its triangle, and the convex hull as a counterclockwise linked list of points.
+type sweep struct {
+	points    []geometry.Point
+	ids       []int
+	triangles []int
+	// halfedges holds the opposite half edge of every half edge, or -1 on the hull.
+	halfedges []int
+	hullStart int
+	hullNext  map[int]int
+	hullPrev  map[int]int
+	// hullTri holds the half edge from a point of the hull to the next one.
+	hullTri map[int]int
+	// hullHash holds points of the hull by their angle from the center, to find quickly an edge seen by a new point.
+	hullHash []int
+	cx       float64
+	cy       float64
+}
+
+// seed starts the triangulation with the triangle of the points closest to the center of the bounding box with
+// the smallest circumcircle, and sorts the other points by their distance from it. It returns false if the points
+// are collinear.
+func (s *sweep) seed(ids []int) bool {
+	if len(ids) < 3 {
+		return false
+	}
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, i := range ids {
+		p := s.points[i]
+		minX = math.Min(minX, p.Lng)
+		minY = math.Min(minY, p.Lat)
+		maxX = math.Max(maxX, p.Lng)
+		maxY = math.Max(maxY, p.Lat)
+	}
+	cx := (minX + maxX) / 2
+	cy := (minY + maxY) / 2
+
+	i0 := -1
+	minDist := math.Inf(1)
+	for _, i := range ids {
+		d := sqDist(s.points[i].Lng, s.points[i].Lat, cx, cy)
+		if d < minDist {
+			i0 = i
+			minDist = d
+		}
+	}
+	p0 := s.points[i0]
+
+	i1 := -1
+	minDist = math.Inf(1)
+	for _, i := range ids {
+		if i == i0 {
+			continue
+		}
+		d := sqDist(s.points[i].Lng, s.points[i].Lat, p0.Lng, p0.Lat)
+		if d < minDist {
+			i1 = i
+			minDist = d
+		}
+	}
+	p1 := s.points[i1]
+
+	i2 := -1
+	minRadius := math.Inf(1)
+	for _, i := range ids {
+		if i == i0 || i == i1 {
+			continue
+		}
+		r := circumradius(p0, p1, s.points[i])
+		if r < minRadius {
+			i2 = i
+			minRadius = r
+		}
+	}
+	if i2 == -1 {
+		return false
+	}
+	if orient(p0, p1, s.points[i2]) < 0 {
+		i1, i2 = i2, i1
+	}
+
+	s.cx, s.cy = circumcenter(s.points[i0], s.points[i1], s.points[i2])
+	dists := map[int]float64{}
+	for _, i := range ids {
+		if i != i0 && i != i1 && i != i2 {
+			s.ids = append(s.ids, i)
+			dists[i] = sqDist(s.points[i].Lng, s.points[i].Lat, s.cx, s.cy)
+		}
+	}
+	sort.SliceStable(s.ids, func(a, b int) bool {
+		return dists[s.ids[a]] < dists[s.ids[b]]
+	})
+
+	s.hullHash = make([]int, int(math.Ceil(math.Sqrt(float64(len(ids))))))
+	for i := range s.hullHash {
+		s.hullHash[i] = -1
+	}
+	s.hullStart = i0
+	s.hullNext = map[int]int{i0: i1, i1: i2, i2: i0}
+	s.hullPrev = map[int]int{i0: i2, i1: i0, i2: i1}
+	s.hullTri = map[int]int{i0: 0, i1: 1, i2: 2}
+	for _, i := range []int{i0, i1, i2} {
+		s.hullHash[s.hashKey(s.points[i])] = i
+	}
+	s.addTriangle(i0, i1, i2, -1, -1, -1)
+	return true
+}
+
+// add joins the point, which is outside the hull, to the edges of the hull it sees.
+func (s *sweep) add(i int) {
+	p := s.points[i]
+
+	// a point of the hull close in angle, the edges seen by the point are around it
+	start := 0
+	key := s.hashKey(p)
+	for j := 0; j < len(s.hullHash); j++ {
+		start = s.hullHash[(key+j)%len(s.hullHash)]
+		if start != -1 && start != s.hullNext[start] {
+			break
+		}
+	}
+	start = s.hullPrev[start]
+	e := start
+	for !s.visible(e, s.hullNext[e], p) {
+		e = s.hullNext[e]
+		if e == start {
+			// the point lies on the hull
+			return
+		}
+	}
+
+	q := s.hullNext[e]
+	t := s.addTriangle(e, i, q, -1, -1, s.hullTri[e])
+	s.hullTri[e] = t
+	s.hullTri[i] = t + 1
+	s.legalize(t + 2)
+
+	// the following edges seen by the point
+	n := q
+	for q = s.hullNext[n]; s.visible(n, q, p); q = s.hullNext[n] {
+		t = s.addTriangle(n, i, q, s.hullTri[i], -1, s.hullTri[n])
+		s.hullTri[i] = t + 1
+		s.legalize(t + 2)
+		s.hullNext[n] = n
+		n = q
+	}
+
+	// the previous edges seen by the point
+	if e == start {
+		for q = s.hullPrev[e]; s.visible(q, e, p); q = s.hullPrev[e] {
+			t = s.addTriangle(q, i, e, -1, s.hullTri[e], s.hullTri[q])
+			s.hullTri[q] = t
+			s.legalize(t + 2)
+			s.hullNext[e] = e
+			e = q
+		}
+	}
+
+	s.hullStart = e
+	s.hullPrev[i] = e
+	s.hullNext[e] = i
+	s.hullPrev[n] = i
+	s.hullNext[i] = n
+	s.hullHash[s.hashKey(p)] = i
+	s.hullHash[s.hashKey(s.points[e])] = e
+}
+
+// visible returns true if the point is strictly on the right of the hull edge from a to b.
+func (s *sweep) visible(a int, b int, p geometry.Point) bool {
+	return orient(s.points[a], s.points[b], p) < 0
+}
+
+func (s *sweep) addTriangle(i0 int, i1 int, i2 int, a int, b int, c int) int {
+	t := len(s.triangles)
+	s.triangles = append(s.triangles, i0, i1, i2)
+	s.halfedges = append(s.halfedges, -1, -1, -1)
+	s.link(t, a)
+	s.link(t+1, b)
+	s.link(t+2, c)
+	return t
+}
+
+func (s *sweep) link(a int, b int) {
+	s.halfedges[a] = b
+	if b != -1 {
+		s.halfedges[b] = a
+	}
+}
+
+// legalize flips the half edge a with its opposite one if the point opposite to it lies in the circumcircle of its
+// triangle, and then checks the edges of the flipped triangles.
+//
+//	      pl                    pl
+//	     /||\                  /  \
+//	  al/ || \bl            al/    \a
+//	   /  ||  \              /      \
+//	  /  a||b  \    flip    /___ar___\
+//	p0\   ||   /p1   =>   p0\---bl---/p1
+//	   \  ||  /              \      /
+//	  ar\ || /br             b\    /br
+//	     \||/                  \  /
+//	      pr                    pr
+func (s *sweep) legalize(a int) {
+	b := s.halfedges[a]
+	if b == -1 {
+		return
+	}
+	a0 := a - a%3
+	b0 := b - b%3
+	al := a0 + (a+1)%3
+	ar := a0 + (a+2)%3
+	bl := b0 + (b+2)%3
+	br := b0 + (b+1)%3
+
+	p0 := s.triangles[ar]
+	pr := s.triangles[a]
+	pl := s.triangles[al]
+	p1 := s.triangles[bl]
+	if !inCircle(s.points[p0], s.points[pr], s.points[pl], s.points[p1]) {
+		return
+	}
+
+	s.triangles[a] = p1
+	s.triangles[b] = p0
+	hbl := s.halfedges[bl]
+	har := s.halfedges[ar]
+	// the hull edges moved to other half edges
+	if hbl == -1 {
+		s.hullTri[p1] = a
+	}
+	if har == -1 {
+		s.hullTri[p0] = b
+	}
+	s.link(a, hbl)
+	s.link(b, har)
+	s.link(ar, bl)
+
+	s.legalize(a)
+	s.legalize(br)
+}
+
+// hashKey returns the bucket of the angle of the point from the center.
+func (s *sweep) hashKey(p geometry.Point) int {
+	dx := p.Lng - s.cx
+	dy := p.Lat - s.cy
+	// a monotonic function of the angle in [0, 1)
+	angle := 0.0
+	if dx != 0 || dy != 0 {
+		r := dx / (math.Abs(dx) + math.Abs(dy))
+		if dy > 0 {
+			angle = (3 - r) / 4
+		} else {
+			angle = (1 + r) / 4
+		}
+	}
+	return int(math.Floor(angle*float64(len(s.hullHash)))) % len(s.hullHash)
+}
+
+// orient returns twice the signed area of the triangle, positive if it is counterclockwise.
+func orient(a geometry.Point, b geometry.Point, c geometry.Point) float64 {
+	return (b.Lng-a.Lng)*(c.Lat-a.Lat) - (b.Lat-a.Lat)*(c.Lng-a.Lng)
+}
+
+// inCircle returns true if the point d lies inside the circumcircle of the counterclockwise triangle abc.
+func inCircle(a geometry.Point, b geometry.Point, c geometry.Point, d geometry.Point) bool {
+	adx := a.Lng - d.Lng
+	ady := a.Lat - d.Lat
+	bdx := b.Lng - d.Lng
+	bdy := b.Lat - d.Lat
+	cdx := c.Lng - d.Lng
+	cdy := c.Lat - d.Lat
+
+	ap := adx*adx + ady*ady
+	bp := bdx*bdx + bdy*bdy
+	cp := cdx*cdx + cdy*cdy
+	return ap*(bdx*cdy-cdx*bdy)-bp*(adx*cdy-cdx*ady)+cp*(adx*bdy-bdx*ady) > 0
+}
+
+// circumcenter returns the center of the circle through the points of the triangle.
+func circumcenter(a geometry.Point, b geometry.Point, c geometry.Point) (float64, float64) {
+	bx := b.Lng - a.Lng
+	by := b.Lat - a.Lat
+	cx := c.Lng - a.Lng
+	cy := c.Lat - a.Lat
+	bl := bx*bx + by*by
+	cl := cx*cx + cy*cy
+	d := 0.5 / (bx*cy - by*cx)
+	return a.Lng + (cy*bl-by*cl)*d, a.Lat + (bx*cl-cx*bl)*d
+}
+
+// circumradius returns the square of the radius of the circle through the points of the triangle, infinite for
+// collinear points.
+func circumradius(a geometry.Point, b geometry.Point, c geometry.Point) float64 {
+	if orient(a, b, c) == 0 {
+		return math.Inf(1)
+	}
+	x, y := circumcenter(a, b, c)
+	return sqDist(a.Lng, a.Lat, x, y)
+}
+
+func sqDist(ax float64, ay float64, bx float64, by float64) float64 {
+	dx := ax - bx
+	dy := ay - by
+	return dx*dx + dy*dy
+}
@@ -0,0 +1,255 @@
+package delaunay
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// checkDelaunay checks that the triangles are counterclockwise, have empty circumcircles and cover the convex hull
+// of the points, which has the area of the triangles.
+func checkDelaunay(t *testing.T, points []geometry.Point, triangles [][3]int, hullArea float64) {
+	area := 0.0
+	for _, tr := range triangles {
+		a, b, c := points[tr[0]], points[tr[1]], points[tr[2]]
+		o := orient(a, b, c)
+		if o <= 0 {
+			t.Fatalf("triangle %v isn't counterclockwise", tr)
+		}
+		area += o / 2
+		for i, p := range points {
+			if i != tr[0] && i != tr[1] && i != tr[2] && inCircle(a, b, c, p) {
+				t.Fatalf("point %v is inside the circumcircle of %v", p, tr)
+			}
+		}
+	}
+	if d := area - hullArea; d > 1e-9 || d < -1e-9 {
+		t.Errorf("area = %v, want %v", area, hullArea)
+	}
+}
+
+func TestTriangulate(t *testing.T) {
+	tests := map[string]struct {
+		points    []geometry.Point
+		triangles int
+		hullArea  float64
+	}{
+		"square": {
+			points:    []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 1}},
+			triangles: 2,
+			hullArea:  1,
+		},
+		"center": {
+			points:    []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: 2}, {Lng: 0, Lat: 2}, {Lng: 1, Lat: 1}},
+			triangles: 4,
+			hullArea:  4,
+		},
+		"repeated": {
+			points:    []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 0, Lat: 1}, {Lng: 1, Lat: 0}},
+			triangles: 1,
+			hullArea:  0.5,
+		},
+		"collinear": {
+			points:    []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 2, Lat: 2}},
+			triangles: 0,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			triangles, err := Triangulate(tt.points)
+			if err != nil {
+				t.Fatalf("Triangulate error %v", err)
+			}
+			assert.Equal(t, len(triangles), tt.triangles)
+			checkDelaunay(t, tt.points, triangles, tt.hullArea)
+		})
+	}
+
+	_, err := Triangulate([]geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}})
+	if err == nil {
+		t.Errorf("Triangulate expected an error for 2 points")
+	}
+}
+
+func TestTriangulateGrid(t *testing.T) {
+	// the cells of a grid have four points on their circumcircle
+	var points []geometry.Point
+	for i := 0; i < 10; i++ {
+		for j := 0; j < 10; j++ {
+			points = append(points, geometry.Point{Lng: float64(i), Lat: float64(j)})
+		}
+	}
+	triangles, err := Triangulate(points)
+	if err != nil {
+		t.Fatalf("Triangulate error %v", err)
+	}
+	assert.Equal(t, len(triangles), 162)
+	checkDelaunay(t, points, triangles, 81)
+}
+
+func TestTriangulateRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	points := []geometry.Point{{Lng: -1, Lat: -1}, {Lng: 1, Lat: -1}, {Lng: 1, Lat: 1}, {Lng: -1, Lat: 1}}
+	for i := 0; i < 500; i++ {
+		points = append(points, geometry.Point{Lng: r.Float64()*2 - 1, Lat: r.Float64()*2 - 1})
+	}
+	triangles, err := Triangulate(points)
+	if err != nil {
+		t.Fatalf("Triangulate error %v", err)
+	}
+	// 2n - 2 - h triangles for n points with h of them on the hull
+	assert.Equal(t, len(triangles), 2*len(points)-2-4)
+	checkDelaunay(t, points, triangles, 4)
+}
+
+func pointCollection(t *testing.T, gjson string) feature.Collection {
+	fc, err := feature.CollectionFromJSON(gjson)
+	if err != nil {
+		t.Fatalf("CollectionFromJSON error %v", err)
+	}
+	return *fc
+}
+
+func TestTIN(t *testing.T) {
+	points := pointCollection(t, `{"type":"FeatureCollection","features":[
+		{"type":"Feature","properties":{"elevation":10},"geometry":{"type":"Point","coordinates":[0,0,1]}},
+		{"type":"Feature","properties":{"elevation":20},"geometry":{"type":"Point","coordinates":[1,0,2]}},
+		{"type":"Feature","properties":{"elevation":30},"geometry":{"type":"Point","coordinates":[1,1,3]}},
+		{"type":"Feature","properties":{"elevation":40},"geometry":{"type":"Point","coordinates":[0,1,4]}}
+	]}`)
+
+	tests := map[string]struct {
+		z     string
+		scale float64
+	}{
+		"property": {z: "elevation", scale: 10},
+		"altitude": {z: "", scale: 1},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fc, err := TIN(points, tt.z)
+			if err != nil {
+				t.Fatalf("TIN error %v", err)
+			}
+			assert.Equal(t, len(fc.Features), 2)
+			for _, f := range fc.Features {
+				poly, err := f.ToPolygon()
+				if err != nil {
+					t.Fatalf("ToPolygon error %v", err)
+				}
+				ring := poly.Coordinates[0].Coordinates
+				assert.Equal(t, len(ring), 4)
+				// the altitudes of the corners, and their elevations ten times them
+				for i, k := range []string{"a", "b", "c"} {
+					want := map[[2]float64]float64{{0, 0}: 1, {1, 0}: 2, {1, 1}: 3, {0, 1}: 4}[[2]float64{ring[i].Lng, ring[i].Lat}]
+					assert.Equal(t, f.Properties[k], want*tt.scale)
+				}
+			}
+		})
+	}
+
+	fc, err := TIN(pointCollection(t, `{"type":"FeatureCollection","features":[
+		{"type":"Feature","properties":{},"geometry":{"type":"Point","coordinates":[0,0]}},
+		{"type":"Feature","properties":{},"geometry":{"type":"Point","coordinates":[1,0]}},
+		{"type":"Feature","properties":{},"geometry":{"type":"Point","coordinates":[0,1]}}
+	]}`), "")
+	if err != nil {
+		t.Fatalf("TIN error %v", err)
+	}
+	assert.Equal(t, len(fc.Features[0].Properties), 0)
+
+	_, err = TIN(pointCollection(t, `{"type":"FeatureCollection","features":[
+		{"type":"Feature","properties":{},"geometry":{"type":"LineString","coordinates":[[0,0],[1,1]]}}
+	]}`), "")
+	if err == nil {
+		t.Errorf("TIN expected an error for a line")
+	}
+}
+
+func TestVoronoi(t *testing.T) {
+	points := pointCollection(t, `{"type":"FeatureCollection","features":[
+		{"type":"Feature","properties":{"depot":"a"},"geometry":{"type":"Point","coordinates":[1,1]}},
+		{"type":"Feature","properties":{"depot":"b"},"geometry":{"type":"Point","coordinates":[3,1]}},
+		{"type":"Feature","properties":{"depot":"c"},"geometry":{"type":"Point","coordinates":[3,3]}},
+		{"type":"Feature","properties":{"depot":"d"},"geometry":{"type":"Point","coordinates":[1,3]}},
+		{"type":"Feature","properties":{"depot":"e"},"geometry":{"type":"Point","coordinates":[10,10]}}
+	]}`)
+	fc, err := Voronoi(points, geojson.BBOX{West: 0, South: 0, East: 4, North: 4})
+	if err != nil {
+		t.Fatalf("Voronoi error %v", err)
+	}
+	// the point outside the bounding box has no cell
+	assert.Equal(t, len(fc.Features), 4)
+	for i, f := range fc.Features {
+		assert.Equal(t, f.Properties["depot"], points.Features[i].Properties["depot"])
+		poly, err := f.ToPolygon()
+		if err != nil {
+			t.Fatalf("ToPolygon error %v", err)
+		}
+		assert.Equal(t, ringArea(poly.Coordinates[0].Coordinates), 4.0)
+		p, err := points.Features[i].ToPoint()
+		if err != nil {
+			t.Fatalf("ToPoint error %v", err)
+		}
+		for _, c := range poly.Coordinates[0].Coordinates {
+			if math.Abs(c.Lng-p.Lng) > 1 || math.Abs(c.Lat-p.Lat) > 1 {
+				t.Errorf("vertex %v of the cell of %v", c, p)
+			}
+		}
+	}
+
+	_, err = Voronoi(points, geojson.BBOX{West: 4, South: 0, East: 0, North: 4})
+	if err == nil {
+		t.Errorf("Voronoi expected an error for an empty bounding box")
+	}
+}
+
+func TestVoronoiRandom(t *testing.T) {
+	// the cells cover the bounding box and every cell contains its point
+	r := rand.New(rand.NewSource(1))
+	var features []feature.Feature
+	for i := 0; i < 200; i++ {
+		g, err := geometry.NewGeometry(&geometry.Point{Lng: r.Float64(), Lat: r.Float64()})
+		if err != nil {
+			t.Fatalf("NewGeometry error %v", err)
+		}
+		features = append(features, feature.Feature{Geometry: *g})
+	}
+	fc, err := Voronoi(feature.Collection{Features: features}, geojson.BBOX{West: 0, South: 0, East: 1, North: 1})
+	if err != nil {
+		t.Fatalf("Voronoi error %v", err)
+	}
+	assert.Equal(t, len(fc.Features), 200)
+	area := 0.0
+	for i, f := range fc.Features {
+		poly, err := f.ToPolygon()
+		if err != nil {
+			t.Fatalf("ToPolygon error %v", err)
+		}
+		ring := poly.Coordinates[0].Coordinates
+		area += ringArea(ring)
+		p, _ := features[i].ToPoint()
+		for j := 1; j < len(ring); j++ {
+			if orient(ring[j-1], ring[j], *p) < 0 {
+				t.Fatalf("point %v outside its cell", p)
+			}
+		}
+	}
+	if math.Abs(area-1) > 1e-9 {
+		t.Errorf("area = %v, want 1", area)
+	}
+}
+
+// ringArea returns the signed area of the closed ring, positive if it is counterclockwise.
+func ringArea(ring []geometry.Point) float64 {
+	area := 0.0
+	for i := 1; i < len(ring); i++ {
+		area += orient(ring[0], ring[i-1], ring[i])
+	}
+	return area / 2
+}
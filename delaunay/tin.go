@@ -0,0 +1,64 @@
+package delaunay
+
+import (
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// TIN takes a collection of Point features and returns their triangulated irregular network, the triangles of their
+// Delaunay triangulation as Polygon features.
+// If z isn't empty the a, b and c properties of every triangle hold the z property of its vertices, in the order of
+// the ring, otherwise they hold the altitudes of the vertices if they have one.
+func TIN(points feature.Collection, z string) (*feature.Collection, error) {
+	pts, err := collectionPoints(points)
+	if err != nil {
+		return nil, err
+	}
+	triangles, err := Triangulate(pts)
+	if err != nil {
+		return nil, err
+	}
+
+	features := make([]feature.Feature, 0, len(triangles))
+	for _, t := range triangles {
+		ring := []geometry.Point{pts[t[0]], pts[t[1]], pts[t[2]], pts[t[0]]}
+		g, err := geometry.NewGeometry(&geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: ring}}})
+		if err != nil {
+			return nil, err
+		}
+
+		var properties map[string]interface{}
+		if z != "" {
+			properties = map[string]interface{}{
+				"a": points.Features[t[0]].Properties[z],
+				"b": points.Features[t[1]].Properties[z],
+				"c": points.Features[t[2]].Properties[z],
+			}
+		} else if pts[t[0]].HasAlt() && pts[t[1]].HasAlt() && pts[t[2]].HasAlt() {
+			properties = map[string]interface{}{
+				"a": *pts[t[0]].Alt,
+				"b": *pts[t[1]].Alt,
+				"c": *pts[t[2]].Alt,
+			}
+		}
+		f, err := feature.New(*g, nil, properties, feature.ID{})
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// collectionPoints returns the points of a collection of Point features.
+func collectionPoints(points feature.Collection) ([]geometry.Point, error) {
+	pts := make([]geometry.Point, 0, len(points.Features))
+	for i := range points.Features {
+		p, err := points.Features[i].ToPoint()
+		if err != nil {
+			return nil, err
+		}
+		pts = append(pts, *p)
+	}
+	return pts, nil
+}
@@ -0,0 +1,132 @@
+package delaunay
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/turf-go/geojson"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// Voronoi takes a collection of Point features and returns their Voronoi diagram clipped to the bounding box, the
+// cell of every point as a Polygon feature with the properties of the point, in the plane of the longitudes and
+// latitudes. Every cell is the part of the bounding box closer to its point than to the neighbours of the point in
+// the Delaunay triangulation.
+// Repeated points share the cell of their first occurrence, and the points whose cell is outside the bounding box
+// have no feature.
+func Voronoi(points feature.Collection, bbox geojson.BBOX) (*feature.Collection, error) {
+	if bbox.West >= bbox.East || bbox.South >= bbox.North {
+		return nil, errors.New("the bounding box must have an area")
+	}
+	pts, err := collectionPoints(points)
+	if err != nil {
+		return nil, err
+	}
+	neighbours, err := neighboursOf(pts)
+	if err != nil {
+		return nil, err
+	}
+
+	firsts := map[[2]float64]int{}
+	features := make([]feature.Feature, 0, len(pts))
+	for i, p := range pts {
+		k := [2]float64{p.Lng, p.Lat}
+		if _, ok := firsts[k]; !ok {
+			firsts[k] = i
+		}
+		cell := [][2]float64{{bbox.West, bbox.South}, {bbox.East, bbox.South}, {bbox.East, bbox.North}, {bbox.West, bbox.North}}
+		for _, j := range neighbours[firsts[k]] {
+			cell = clipHalfPlane(cell, [2]float64{p.Lng, p.Lat}, [2]float64{pts[j].Lng, pts[j].Lat})
+		}
+		if len(cell) < 3 {
+			continue
+		}
+
+		ring := make([]geometry.Point, 0, len(cell)+1)
+		for _, c := range cell {
+			ring = append(ring, geometry.Point{Lng: c[0], Lat: c[1]})
+		}
+		ring = append(ring, ring[0])
+		g, err := geometry.NewGeometry(&geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: ring}}})
+		if err != nil {
+			return nil, err
+		}
+		properties := make(map[string]interface{}, len(points.Features[i].Properties))
+		for k, v := range points.Features[i].Properties {
+			properties[k] = v
+		}
+		f, err := feature.New(*g, nil, properties, feature.ID{})
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// neighboursOf returns the indexes of the points joined to every point by an edge of the Delaunay triangulation,
+// or all the other points if there are less than 3 of them or they are collinear.
+func neighboursOf(pts []geometry.Point) (map[int][]int, error) {
+	neighbours := map[int][]int{}
+	var triangles [][3]int
+	if len(pts) >= 3 {
+		var err error
+		triangles, err = Triangulate(pts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(triangles) == 0 {
+		for i, p := range pts {
+			for j, q := range pts {
+				if p.Lng != q.Lng || p.Lat != q.Lat {
+					neighbours[i] = append(neighbours[i], j)
+				}
+			}
+		}
+		return neighbours, nil
+	}
+
+	// the edges inside the triangulation belong to two triangles
+	seen := map[[2]int]bool{}
+	for _, t := range triangles {
+		for k := 0; k < 3; k++ {
+			a, b := t[k], t[(k+1)%3]
+			if a > b {
+				a, b = b, a
+			}
+			if !seen[[2]int{a, b}] {
+				seen[[2]int{a, b}] = true
+				neighbours[a] = append(neighbours[a], b)
+				neighbours[b] = append(neighbours[b], a)
+			}
+		}
+	}
+	return neighbours, nil
+}
+
+// clipHalfPlane returns the part of the convex polygon closer to the point p than to the point q.
+func clipHalfPlane(polygon [][2]float64, p [2]float64, q [2]float64) [][2]float64 {
+	mid := [2]float64{(p[0] + q[0]) / 2, (p[1] + q[1]) / 2}
+	dir := [2]float64{q[0] - p[0], q[1] - p[1]}
+	// positive on the side of q
+	side := func(c [2]float64) float64 {
+		return (c[0]-mid[0])*dir[0] + (c[1]-mid[1])*dir[1]
+	}
+
+	var clipped [][2]float64
+	for i := range polygon {
+		a := polygon[i]
+		b := polygon[(i+1)%len(polygon)]
+		sa := side(a)
+		sb := side(b)
+		if sa <= 0 {
+			clipped = append(clipped, a)
+		}
+		if (sa < 0 && sb > 0) || (sa > 0 && sb < 0) {
+			t := sa / (sa - sb)
+			clipped = append(clipped, [2]float64{a[0] + (b[0]-a[0])*t, a[1] + (b[1]-a[1])*t})
+		}
+	}
+	return clipped
+}
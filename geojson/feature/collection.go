@@ -1,8 +1,10 @@
 package feature
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/tomchavakis/turf-go/geojson"
 )
@@ -11,8 +13,15 @@ import (
 type Collection struct {
 	Type     geojson.OBjectType `json:"type"`
 	Features []Feature          `json:"features"`
+	// Bbox is the bounding box of the feature collection.
+	Bbox []float64 `json:"bbox,omitempty"`
+	// ForeignMembers holds the members of the object that aren't defined by the GeoJSON spec.
+	// https://tools.ietf.org/html/rfc7946#section-6.1
+	ForeignMembers map[string]interface{} `json:"-"`
 }
 
+var collectionMembers = []string{"type", "features", "bbox"}
+
 // NewFeatureCollection initializes a new instance of FeatureCollection
 func NewFeatureCollection(features []Feature) (*Collection, error) {
 	return &Collection{Features: features, Type: geojson.FeatureCollection}, nil
@@ -34,3 +43,40 @@ func CollectionFromJSON(gjson string) (*Collection, error) {
 	return &collection, nil
 
 }
+
+// MarshalJSON encodes the Collection as a GeoJSON FeatureCollection.
+func (c Collection) MarshalJSON() ([]byte, error) {
+	type plain Collection
+	p := plain(c)
+	p.Type = geojson.FeatureCollection
+	if p.Features == nil {
+		p.Features = []Feature{}
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return geojson.AppendForeignMembers(b, c.ForeignMembers, collectionMembers...)
+}
+
+// UnmarshalJSON decodes a GeoJSON FeatureCollection.
+func (c *Collection) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	type plain Collection
+	var p plain
+	err := json.Unmarshal(data, &p)
+	if err != nil {
+		return err
+	}
+	if p.Type != geojson.FeatureCollection {
+		return fmt.Errorf("invalid type %q, expected %q", p.Type, geojson.FeatureCollection)
+	}
+	p.ForeignMembers, err = geojson.ForeignMembers(data, collectionMembers...)
+	if err != nil {
+		return err
+	}
+	*c = Collection(p)
+	return nil
+}
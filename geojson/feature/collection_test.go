@@ -0,0 +1,45 @@
+package feature
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson"
+)
+
+func TestCollectionJSON(t *testing.T) {
+	gjson := `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[102,0.5]},"properties":{"prop0":"value0"}}],"bbox":[102,0.5,102,0.5],"name":"points"}`
+
+	fc, err := CollectionFromJSON(gjson)
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	assert.Equal(t, fc.Type, geojson.FeatureCollection)
+	assert.Equal(t, len(fc.Features), 1)
+	assert.Equal(t, len(fc.Bbox), 4)
+	assert.Equal(t, fc.ForeignMembers["name"], "points")
+
+	b, err := json.Marshal(fc)
+	if err != nil {
+		t.Errorf("MarshalJSON error %v", err)
+	}
+	assert.Equal(t, string(b), gjson)
+
+	_, err = CollectionFromJSON(`{"type":"Feature","geometry":null,"properties":null}`)
+	if err == nil {
+		t.Error("a feature should not be decoded as a feature collection")
+	}
+}
+
+func TestEmptyCollectionJSON(t *testing.T) {
+	fc, err := NewFeatureCollection(nil)
+	if err != nil {
+		t.Errorf("NewFeatureCollection error %v", err)
+	}
+	b, err := json.Marshal(fc)
+	if err != nil {
+		t.Errorf("MarshalJSON error %v", err)
+	}
+	assert.Equal(t, string(b), `{"type":"FeatureCollection","features":[]}`)
+}
@@ -1,8 +1,10 @@
 package feature
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/tomchavakis/turf-go/geojson"
 	"github.com/tomchavakis/turf-go/geojson/geometry"
@@ -13,7 +15,8 @@ import (
 // occurs in a GeoJSON text.
 // https://tools.ietf.org/html/rfc7946#section-3.2
 type Feature struct {
-	ID string `json:"id"`
+	// ID is the identifier of the feature, either a string or a number, or the zero ID if it has none.
+	ID ID `json:"id"`
 	// A Feature object has a "Type" member with the value "Feature".
 	Type geojson.OBjectType `json:"type"`
 	// A Feature object has a member with the name "properties". The
@@ -27,10 +30,23 @@ type Feature struct {
 	// defined above or, in the case that the Feature is unlocated, a
 	// JSON null value.
 	Geometry geometry.Geometry `json:"geometry"`
+	// ForeignMembers holds the members of the object that aren't defined by the GeoJSON spec.
+	// https://tools.ietf.org/html/rfc7946#section-6.1
+	ForeignMembers map[string]interface{} `json:"-"`
+}
+
+var featureMembers = []string{"id", "type", "properties", "bbox", "geometry"}
+
+type featureJSON struct {
+	ID         *ID                    `json:"id,omitempty"`
+	Type       geojson.OBjectType     `json:"type"`
+	Bbox       []float64              `json:"bbox,omitempty"`
+	Geometry   *geometry.Geometry     `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
 }
 
 // New initializes a new Feature
-func New(geometry geometry.Geometry, bbox []float64, properties map[string]interface{}, id string) (*Feature, error) {
+func New(geometry geometry.Geometry, bbox []float64, properties map[string]interface{}, id ID) (*Feature, error) {
 	return &Feature{
 		ID:         id,
 		Geometry:   geometry,
@@ -57,26 +73,71 @@ func FromJSON(gjson string) (*Feature, error) {
 
 }
 
-// ToPoint converts the Feature to Point.
-func (f *Feature) ToPoint() (*geometry.Point, error) {
-	if f.Geometry.GeoJSONType != geojson.Point {
-		return nil, errors.New("the feature must be a point")
+// MarshalJSON encodes the Feature as a GeoJSON Feature object.
+// An unlocated Feature, which is a Feature without a geometry type, is encoded with a null geometry.
+func (f Feature) MarshalJSON() ([]byte, error) {
+	fj := featureJSON{
+		Type:       geojson.Feature,
+		Bbox:       f.Bbox,
+		Properties: f.Properties,
+	}
+	if !f.ID.IsZero() {
+		fj.ID = &f.ID
 	}
+	if f.Geometry.GeoJSONType != "" {
+		fj.Geometry = &f.Geometry
+	}
+	b, err := json.Marshal(fj)
+	if err != nil {
+		return nil, err
+	}
+	return geojson.AppendForeignMembers(b, f.ForeignMembers, featureMembers...)
+}
 
-	var coords []float64
-	ccc, err := json.Marshal(f.Geometry.Coordinates)
+// UnmarshalJSON decodes a GeoJSON Feature object.
+func (f *Feature) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var fj featureJSON
+	err := json.Unmarshal(data, &fj)
 	if err != nil {
-		return nil, errors.New("cannot marshal object")
+		return err
 	}
-	err = json.Unmarshal(ccc, &coords)
+	if fj.Type != geojson.Feature {
+		return fmt.Errorf("invalid type %q, expected %q", fj.Type, geojson.Feature)
+	}
+	fm, err := geojson.ForeignMembers(data, featureMembers...)
 	if err != nil {
-		return nil, errors.New("cannot unmarshal object")
+		return err
 	}
-	var pos = geometry.Point{}
-	pos.Lat = coords[1]
-	pos.Lng = coords[0]
 
-	return &pos, nil
+	*f = Feature{
+		Type:           fj.Type,
+		Properties:     fj.Properties,
+		Bbox:           fj.Bbox,
+		ForeignMembers: fm,
+	}
+	if fj.ID != nil {
+		f.ID = *fj.ID
+	}
+	if fj.Geometry != nil {
+		f.Geometry = *fj.Geometry
+	}
+	return nil
+}
+
+// ToObject converts the Feature geometry to its typed geometry.
+func (f *Feature) ToObject() (geometry.Object, error) {
+	return f.Geometry.ToObject()
+}
+
+// ToPoint converts the Feature to Point.
+func (f *Feature) ToPoint() (*geometry.Point, error) {
+	if f.Geometry.GeoJSONType != geojson.Point {
+		return nil, errors.New("the feature must be a point")
+	}
+	return f.Geometry.ToPoint()
 }
 
 // ToMultiPoint converts the Feature to MultiPoint type.
@@ -84,22 +145,7 @@ func (f *Feature) ToMultiPoint() (*geometry.MultiPoint, error) {
 	if f.Geometry.GeoJSONType != geojson.MultiPoint {
 		return nil, errors.New("the feature must be a MultiPoint")
 	}
-
-	var m geometry.MultiPoint
-	var coords [][]float64
-	ccc, err := json.Marshal(f.Geometry.Coordinates)
-	if err != nil {
-		return nil, errors.New("cannot marshal object")
-	}
-	err = json.Unmarshal(ccc, &coords)
-	if err != nil {
-		return nil, errors.New("cannot unmarshal object")
-	}
-	for i := 0; i < len(coords); i++ {
-		p := geometry.NewPoint(coords[i][1], coords[i][0])
-		m.Coordinates = append(m.Coordinates, *p)
-	}
-	return &m, nil
+	return f.Geometry.ToMultiPoint()
 }
 
 // ToPolygon converts a Polygon Feature to Polygon geometry.
@@ -107,38 +153,7 @@ func (f *Feature) ToPolygon() (*geometry.Polygon, error) {
 	if f.Geometry.GeoJSONType != geojson.Polygon {
 		return nil, errors.New("the feature must be a polygon")
 	}
-	var coords = []geometry.LineString{}
-
-	var polygonCoordinates [][][]float64
-	ccc, err := json.Marshal(f.Geometry.Coordinates)
-	if err != nil {
-		return nil, errors.New("cannot marshal object")
-	}
-	err = json.Unmarshal(ccc, &polygonCoordinates)
-	if err != nil {
-		return nil, errors.New("cannot marshal object")
-	}
-
-	for i := 0; i < len(polygonCoordinates); i++ {
-		var posArray = []geometry.Point{}
-		for j := 0; j < len(polygonCoordinates[i]); j++ {
-			pos := geometry.Point{
-				Lng: polygonCoordinates[i][j][0],
-				Lat: polygonCoordinates[i][j][1],
-			}
-			posArray = append(posArray, pos)
-		}
-		ln := geometry.LineString{
-			Coordinates: posArray,
-		}
-		coords = append(coords, ln)
-	}
-	poly, err := geometry.NewPolygon(coords)
-	if err != nil {
-		return nil, errors.New("cannot create a new polygon")
-	}
-	return poly, nil
-
+	return f.Geometry.ToPolygon()
 }
 
 // ToMultiPolygon converts a MultiPolygon Feature to MultiPolygon geometry.
@@ -146,45 +161,7 @@ func (f *Feature) ToMultiPolygon() (*geometry.MultiPolygon, error) {
 	if f.Geometry.GeoJSONType != geojson.MultiPolygon {
 		return nil, errors.New("the feature must be a multiPolygon")
 	}
-	var multiPolygonCoordinates [][][][]float64
-	ccc, err := json.Marshal(f.Geometry.Coordinates)
-	if err != nil {
-		return nil, errors.New("cannot marshal object")
-	}
-	err = json.Unmarshal(ccc, &multiPolygonCoordinates)
-
-	if err != nil {
-		return nil, errors.New("cannot marshal object")
-	}
-
-	var polys = []geometry.Polygon{}
-	for k := 0; k < len(multiPolygonCoordinates); k++ {
-		var coords = []geometry.LineString{}
-		for i := 0; i < len(multiPolygonCoordinates[k]); i++ {
-			var posArray = []geometry.Point{}
-			for j := 0; j < len(multiPolygonCoordinates[k][i]); j++ {
-				pos := geometry.Point{
-					Lng: multiPolygonCoordinates[k][i][j][0],
-					Lat: multiPolygonCoordinates[k][i][j][1],
-				}
-				posArray = append(posArray, pos)
-			}
-			ln := geometry.LineString{
-				Coordinates: posArray,
-			}
-			coords = append(coords, ln)
-		}
-		poly := geometry.Polygon{
-			Coordinates: coords,
-		}
-		polys = append(polys, poly)
-	}
-
-	poly, err := geometry.NewMultiPolygon(polys)
-	if err != nil {
-		return nil, errors.New("cannot creat a new polygon")
-	}
-	return poly, nil
+	return f.Geometry.ToMultiPolygon()
 }
 
 // ToLineString converts a ToLineString Feature to ToLineString geometry.
@@ -192,31 +169,7 @@ func (f *Feature) ToLineString() (*geometry.LineString, error) {
 	if f.Geometry.GeoJSONType != geojson.LineString {
 		return nil, errors.New("the feature must be a linestring")
 	}
-
-	var coords [][]float64
-	ccc, err := json.Marshal(f.Geometry.Coordinates)
-	if err != nil {
-		return nil, errors.New("cannot marshal object")
-	}
-	err = json.Unmarshal(ccc, &coords)
-	if err != nil {
-		return nil, errors.New("cannot marshal object")
-	}
-
-	var coordinates []geometry.Point
-	for _, coord := range coords {
-		p := geometry.Point{
-			Lat: coord[1],
-			Lng: coord[0],
-		}
-		coordinates = append(coordinates, p)
-	}
-
-	lineString, err := geometry.NewLineString(coordinates)
-	if err != nil {
-		return nil, errors.New("cannot creat a new polygon")
-	}
-	return lineString, nil
+	return f.Geometry.ToLineString()
 }
 
 // ToMultiLineString converts a MultiLineString faeture to MultiLineString geometry.
@@ -224,35 +177,13 @@ func (f *Feature) ToMultiLineString() (*geometry.MultiLineString, error) {
 	if f.Geometry.GeoJSONType != geojson.MiltiLineString {
 		return nil, errors.New("the feature must be a multiLineString")
 	}
-	var coords [][][]float64
-	ccc, err := json.Marshal(f.Geometry.Coordinates)
-	if err != nil {
-		return nil, errors.New("cannot marshal object")
-	}
-
-	err = json.Unmarshal(ccc, &coords)
-	if err != nil {
-		return nil, errors.New("cannot marshal object")
-	}
-
-	var coordinates []geometry.LineString
-	for i := 0; i < len(coords); i++ {
-		var ls geometry.LineString
-		var points []geometry.Point
-		for j := 0; j < len(coords[i]); j++ {
-			p := geometry.Point{
-				Lat: coords[i][j][1],
-				Lng: coords[i][j][0],
-			}
-			points = append(points, p)
-		}
-		ls.Coordinates = points
-		coordinates = append(coordinates, ls)
-	}
+	return f.Geometry.ToMultiLineString()
+}
 
-	ml, err := geometry.NewMultiLineString(coordinates)
-	if err != nil {
-		return nil, errors.New("can't create a new multiLineString")
+// ToGeometryCollection converts a GeometryCollection feature to GeometryCollection geometry.
+func (f *Feature) ToGeometryCollection() (*geometry.Collection, error) {
+	if f.Geometry.GeoJSONType != geojson.GeometryCollection {
+		return nil, errors.New("the feature must be a geometryCollection")
 	}
-	return ml, nil
+	return f.Geometry.ToGeometryCollection()
 }
@@ -0,0 +1,167 @@
+package feature
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+func TestFeature_MarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		feature Feature
+		want    string
+	}{
+		"point": {
+			feature: Feature{
+				ID:         StringID("1"),
+				Type:       geojson.Feature,
+				Properties: map[string]interface{}{"name": "a"},
+				Geometry: geometry.Geometry{
+					GeoJSONType: geojson.Point,
+					Coordinates: []float64{102, 0.5},
+				},
+			},
+			want: `{"id":"1","type":"Feature","geometry":{"type":"Point","coordinates":[102,0.5]},"properties":{"name":"a"}}`,
+		},
+		"numeric id": {
+			feature: Feature{ID: NumberID("12"), Type: geojson.Feature},
+			want:    `{"id":12,"type":"Feature","geometry":null,"properties":null}`,
+		},
+		"unlocated feature": {
+			feature: Feature{},
+			want:    `{"type":"Feature","geometry":null,"properties":null}`,
+		},
+		"bbox and foreign members": {
+			feature: Feature{
+				Bbox:           []float64{102, 0.5, 102, 0.5},
+				ForeignMembers: map[string]interface{}{"title": "example", "geometry": "ignored"},
+				Geometry: geometry.Geometry{
+					GeoJSONType: geojson.Point,
+					Coordinates: []float64{102, 0.5},
+				},
+			},
+			want: `{"type":"Feature","bbox":[102,0.5,102,0.5],"geometry":{"type":"Point","coordinates":[102,0.5]},"properties":null,"title":"example"}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			b, err := json.Marshal(tt.feature)
+			if err != nil {
+				t.Errorf("MarshalJSON error = %v", err)
+				return
+			}
+			assert.Equal(t, string(b), tt.want)
+		})
+	}
+}
+
+func TestFeature_UnmarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		json    string
+		want    Feature
+		wantErr bool
+	}{
+		"numeric id": {
+			json: `{"type":"Feature","id":12,"geometry":null,"properties":null}`,
+			want: Feature{ID: NumberID("12"), Type: geojson.Feature},
+		},
+		"foreign members": {
+			json: `{"type":"Feature","id":"a","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"k":"v"},"title":"example"}`,
+			want: Feature{
+				ID:         StringID("a"),
+				Type:       geojson.Feature,
+				Properties: map[string]interface{}{"k": "v"},
+				Geometry: geometry.Geometry{
+					GeoJSONType: geojson.Point,
+					Coordinates: []float64{1, 2},
+				},
+				ForeignMembers: map[string]interface{}{"title": "example"},
+			},
+		},
+		"invalid type": {
+			json:    `{"type":"Point","coordinates":[1,2]}`,
+			wantErr: true,
+		},
+		"invalid id": {
+			json:    `{"type":"Feature","id":[1],"geometry":null,"properties":null}`,
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var f Feature
+			err := json.Unmarshal([]byte(tt.json), &f)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(f, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %+v, want %+v", f, tt.want)
+			}
+		})
+	}
+}
+
+func TestID(t *testing.T) {
+	tests := map[string]struct {
+		id       ID
+		str      string
+		isNumber bool
+		json     string
+	}{
+		"string": {id: StringID("a"), str: "a", json: `"a"`},
+		"number": {id: NumberID("9007199254740993"), str: "9007199254740993", isNumber: true, json: `9007199254740993`},
+		"zero":   {id: ID{}, str: "", json: `null`},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.id.String(), tt.str)
+			assert.Equal(t, tt.id.IsNumber(), tt.isNumber)
+			b, err := json.Marshal(tt.id)
+			if err != nil {
+				t.Fatalf("MarshalJSON error = %v", err)
+			}
+			assert.Equal(t, string(b), tt.json)
+			var got ID
+			err = json.Unmarshal(b, &got)
+			if err != nil {
+				t.Fatalf("UnmarshalJSON error = %v", err)
+			}
+			assert.Equal(t, got, tt.id)
+		})
+	}
+
+	f, err := New(geometry.Geometry{}, nil, nil, StringID("1"))
+	if err != nil {
+		t.Fatalf("New error = %v", err)
+	}
+	assert.Equal(t, f.ID, StringID("1"))
+	assert.Equal(t, StringID("").IsZero(), true)
+}
+
+func TestFeature_RoundTrip(t *testing.T) {
+	tests := map[string]string{
+		"string id":  `{"id":"1","type":"Feature","geometry":null,"properties":null}`,
+		"numeric id": `{"id":1,"type":"Feature","geometry":null,"properties":null}`,
+		"large id":   `{"id":9007199254740993,"type":"Feature","geometry":null,"properties":null}`,
+		"float id":   `{"id":1.50,"type":"Feature","geometry":null,"properties":null}`,
+		"no id":      `{"type":"Feature","geometry":null,"properties":null}`,
+	}
+	for name, gjson := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := FromJSON(gjson)
+			if err != nil {
+				t.Fatalf("FromJSON error = %v", err)
+			}
+			b, err := json.Marshal(f)
+			if err != nil {
+				t.Fatalf("MarshalJSON error = %v", err)
+			}
+			assert.Equal(t, string(b), gjson)
+		})
+	}
+}
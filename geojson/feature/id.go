@@ -0,0 +1,76 @@
+package feature
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// ID is the identifier of a Feature, either a string or a number. A number keeps the JSON text it was decoded from,
+// so that it is encoded back exactly even when it doesn't fit in a float64. The zero ID is no identifier.
+// https://tools.ietf.org/html/rfc7946#section-3.2
+type ID struct {
+	value  string
+	number bool
+}
+
+// StringID returns the identifier with the string value.
+func StringID(s string) ID {
+	return ID{value: s}
+}
+
+// NumberID returns the identifier with the number value.
+func NumberID(n json.Number) ID {
+	return ID{value: n.String(), number: true}
+}
+
+// String returns the string value of the identifier, or the JSON text of its number.
+func (id ID) String() string {
+	return id.value
+}
+
+// IsNumber reports whether the identifier is a number.
+func (id ID) IsNumber() bool {
+	return id.number
+}
+
+// IsZero reports whether the feature has no identifier.
+func (id ID) IsZero() bool {
+	return id == ID{}
+}
+
+// MarshalJSON encodes the identifier as a JSON string or number, or null if it is zero.
+func (id ID) MarshalJSON() ([]byte, error) {
+	if id.IsZero() {
+		return []byte("null"), nil
+	}
+	if id.number {
+		return []byte(id.value), nil
+	}
+	return json.Marshal(id.value)
+}
+
+// UnmarshalJSON decodes a JSON string or number, a null value being the zero identifier.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*id = ID{}
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			return err
+		}
+		*id = StringID(s)
+		return nil
+	}
+	var n json.Number
+	err := json.Unmarshal(data, &n)
+	if err != nil {
+		return errors.New("the id must be either a string or a number")
+	}
+	*id = NumberID(n)
+	return nil
+}
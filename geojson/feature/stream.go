@@ -0,0 +1,282 @@
+package feature
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/tomchavakis/turf-go/geojson"
+)
+
+// recordSeparator is the ASCII RS character which starts every record of a GeoJSON text sequence.
+// https://tools.ietf.org/html/rfc8142#section-2
+const recordSeparator = 0x1E
+
+// CollectionReader reads the features of a GeoJSON FeatureCollection one at a time,
+// so that the whole collection never has to be held in memory.
+type CollectionReader struct {
+	dec *json.Decoder
+	// inFeatures is true while the reader is positioned inside the features array.
+	inFeatures bool
+	// seen is true once the features member has been found.
+	seen    bool
+	started bool
+	done    bool
+}
+
+// NewCollectionReader initializes a new CollectionReader which reads from r.
+func NewCollectionReader(r io.Reader) *CollectionReader {
+	return &CollectionReader{dec: json.NewDecoder(r)}
+}
+
+// Read returns the next feature of the collection. It returns io.EOF when there are no more features.
+func (c *CollectionReader) Read() (*Feature, error) {
+	if c.done {
+		return nil, io.EOF
+	}
+	if !c.started {
+		c.started = true
+		err := c.expectDelim('{')
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !c.inFeatures {
+		err := c.seekFeatures()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.dec.More() {
+		var f Feature
+		err := c.dec.Decode(&f)
+		if err != nil {
+			return nil, err
+		}
+		return &f, nil
+	}
+
+	// end of the features array, read the remaining members of the collection
+	err := c.expectDelim(']')
+	if err != nil {
+		return nil, err
+	}
+	c.inFeatures = false
+	for c.dec.More() {
+		_, err = c.readMember()
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = c.expectDelim('}')
+	if err != nil {
+		return nil, err
+	}
+	c.done = true
+	return nil, io.EOF
+}
+
+// seekFeatures skips the members of the collection until the beginning of the features array.
+func (c *CollectionReader) seekFeatures() error {
+	for c.dec.More() {
+		found, err := c.readMember()
+		if err != nil {
+			return err
+		}
+		if found {
+			c.inFeatures = true
+			return nil
+		}
+	}
+	return errors.New("the features member is missing")
+}
+
+// readMember reads the next member of the collection object. It consumes the whole value of every member
+// apart from the features member, in which case it stops after the opening bracket of the array and returns true.
+func (c *CollectionReader) readMember() (bool, error) {
+	tok, err := c.dec.Token()
+	if err != nil {
+		return false, err
+	}
+	key, ok := tok.(string)
+	if !ok {
+		return false, errors.New("invalid feature collection")
+	}
+
+	switch key {
+	case "features":
+		if c.seen {
+			return false, errors.New("duplicate features member")
+		}
+		c.seen = true
+		return true, c.expectDelim('[')
+	case "type":
+		var tp geojson.OBjectType
+		err = c.dec.Decode(&tp)
+		if err != nil {
+			return false, err
+		}
+		if tp != geojson.FeatureCollection {
+			return false, fmt.Errorf("invalid type %q, expected %q", tp, geojson.FeatureCollection)
+		}
+	default:
+		var value json.RawMessage
+		err = c.dec.Decode(&value)
+		if err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+func (c *CollectionReader) expectDelim(delim json.Delim) error {
+	tok, err := c.dec.Token()
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("invalid feature collection, expected %q", delim)
+	}
+	return nil
+}
+
+// SeqReader reads the features of a GeoJSON text sequence one at a time.
+// Records start with the RS character as described in https://tools.ietf.org/html/rfc8142. Input without RS characters
+// is read as newline-delimited GeoJSON, one feature per line.
+type SeqReader struct {
+	r *bufio.Reader
+	// delim is the byte which separates the records, it is set by the first byte of the input.
+	delim byte
+}
+
+// NewSeqReader initializes a new SeqReader which reads from r.
+func NewSeqReader(r io.Reader) *SeqReader {
+	return &SeqReader{r: bufio.NewReader(r)}
+}
+
+// Read returns the next feature of the sequence. It returns io.EOF when there are no more features.
+func (s *SeqReader) Read() (*Feature, error) {
+	if s.delim == 0 {
+		err := s.detectDelim()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		record, err := s.r.ReadBytes(s.delim)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		eof := err == io.EOF
+
+		record = bytes.TrimSpace(bytes.Trim(record, string([]byte{recordSeparator})))
+		if len(record) > 0 {
+			var f Feature
+			err = json.Unmarshal(record, &f)
+			if err != nil {
+				return nil, err
+			}
+			return &f, nil
+		}
+		if eof {
+			return nil, io.EOF
+		}
+	}
+}
+
+func (s *SeqReader) detectDelim() error {
+	for {
+		b, err := s.r.ReadByte()
+		if err != nil {
+			return err
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		case recordSeparator:
+			s.delim = recordSeparator
+			return nil
+		}
+		s.delim = '\n'
+		return s.r.UnreadByte()
+	}
+}
+
+// CollectionWriter writes features to a GeoJSON FeatureCollection one at a time.
+// Close must be called to terminate the collection.
+type CollectionWriter struct {
+	w     io.Writer
+	count int
+}
+
+// NewCollectionWriter initializes a new CollectionWriter which writes to w.
+func NewCollectionWriter(w io.Writer) *CollectionWriter {
+	return &CollectionWriter{w: w}
+}
+
+// Write appends a feature to the collection.
+func (c *CollectionWriter) Write(f Feature) error {
+	b, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	prefix := ","
+	if c.count == 0 {
+		prefix = `{"type":"FeatureCollection","features":[`
+	}
+	_, err = io.WriteString(c.w, prefix)
+	if err != nil {
+		return err
+	}
+	_, err = c.w.Write(b)
+	if err != nil {
+		return err
+	}
+	c.count++
+	return nil
+}
+
+// Close terminates the collection. It doesn't close the underlying writer.
+func (c *CollectionWriter) Close() error {
+	suffix := "]}"
+	if c.count == 0 {
+		suffix = `{"type":"FeatureCollection","features":[]}`
+	}
+	_, err := io.WriteString(c.w, suffix)
+	return err
+}
+
+// SeqWriter writes features as a GeoJSON text sequence, every feature prefixed with the RS character and
+// followed by a line feed.
+// https://tools.ietf.org/html/rfc8142
+type SeqWriter struct {
+	w io.Writer
+}
+
+// NewSeqWriter initializes a new SeqWriter which writes to w.
+func NewSeqWriter(w io.Writer) *SeqWriter {
+	return &SeqWriter{w: w}
+}
+
+// Write appends a feature to the sequence.
+func (s *SeqWriter) Write(f Feature) error {
+	b, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	record := make([]byte, 0, len(b)+2)
+	record = append(record, recordSeparator)
+	record = append(record, b...)
+	record = append(record, '\n')
+	_, err = s.w.Write(record)
+	return err
+}
@@ -0,0 +1,194 @@
+package feature
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/utils"
+)
+
+const AreaFeatureCollection = "../../test-data/area-feature-collection.json"
+
+func readAll(t *testing.T, read func() (*Feature, error)) []Feature {
+	var features []Feature
+	for {
+		f, err := read()
+		if err == io.EOF {
+			return features
+		}
+		if err != nil {
+			t.Fatalf("Read error %v", err)
+		}
+		features = append(features, *f)
+	}
+}
+
+func TestCollectionReader(t *testing.T) {
+	gjson, err := utils.LoadJSONFixture(AreaFeatureCollection)
+	if err != nil {
+		t.Errorf("LoadJSONFixture error: %v", err)
+	}
+	fc, err := CollectionFromJSON(gjson)
+	if err != nil {
+		t.Errorf("CollectionFromJSON error: %v", err)
+	}
+
+	file, err := os.Open(AreaFeatureCollection)
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	defer file.Close()
+
+	features := readAll(t, NewCollectionReader(file).Read)
+	if !reflect.DeepEqual(features, fc.Features) {
+		t.Error("the streamed features are not equal to the decoded collection")
+	}
+}
+
+func TestCollectionReaderMembers(t *testing.T) {
+	tests := map[string]struct {
+		json    string
+		want    int
+		wantErr bool
+	}{
+		"members after the features": {
+			json: `{"features":[{"type":"Feature","geometry":null,"properties":null},{"type":"Feature","geometry":null,"properties":null}],"bbox":[1,2,3,4],"type":"FeatureCollection"}`,
+			want: 2,
+		},
+		"foreign members before the features": {
+			json: `{"type":"FeatureCollection","name":{"nested":[1,2]},"features":[{"type":"Feature","geometry":null,"properties":null}]}`,
+			want: 1,
+		},
+		"empty collection": {
+			json: `{"type":"FeatureCollection","features":[]}`,
+			want: 0,
+		},
+		"invalid type": {
+			json:    `{"type":"Feature","features":[]}`,
+			wantErr: true,
+		},
+		"missing features": {
+			json:    `{"type":"FeatureCollection"}`,
+			wantErr: true,
+		},
+		"duplicate features": {
+			json:    `{"type":"FeatureCollection","features":[],"features":[]}`,
+			wantErr: true,
+		},
+		"truncated": {
+			json:    `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":null,"properties":null}`,
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewCollectionReader(strings.NewReader(tt.json))
+			count := 0
+			for {
+				_, err := r.Read()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					if !tt.wantErr {
+						t.Errorf("Read error %v", err)
+					}
+					return
+				}
+				count++
+			}
+			if tt.wantErr {
+				t.Error("Read should fail")
+			}
+			assert.Equal(t, count, tt.want)
+		})
+	}
+}
+
+func TestSeqReader(t *testing.T) {
+	tests := map[string]struct {
+		seq  string
+		want []string
+	}{
+		"rfc8142": {
+			seq:  "\x1e{\"type\":\"Feature\",\"id\":\"1\",\"geometry\":null,\"properties\":null}\n\x1e{\n\"type\":\"Feature\",\"id\":\"2\",\n\"geometry\":null,\"properties\":null}\n",
+			want: []string{"1", "2"},
+		},
+		"newline delimited": {
+			seq:  "{\"type\":\"Feature\",\"id\":\"1\",\"geometry\":null,\"properties\":null}\n\n{\"type\":\"Feature\",\"id\":\"2\",\"geometry\":null,\"properties\":null}",
+			want: []string{"1", "2"},
+		},
+		"empty": {
+			seq: "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			features := readAll(t, NewSeqReader(strings.NewReader(tt.seq)).Read)
+			var ids []string
+			for _, f := range features {
+				ids = append(ids, f.ID.String())
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("Read() ids = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriters(t *testing.T) {
+	gjson, err := utils.LoadJSONFixture(AreaFeatureCollection)
+	if err != nil {
+		t.Errorf("LoadJSONFixture error: %v", err)
+	}
+	fc, err := CollectionFromJSON(gjson)
+	if err != nil {
+		t.Errorf("CollectionFromJSON error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	cw := NewCollectionWriter(&buf)
+	for _, f := range fc.Features {
+		err = cw.Write(f)
+		if err != nil {
+			t.Errorf("Write error %v", err)
+		}
+	}
+	err = cw.Close()
+	if err != nil {
+		t.Errorf("Close error %v", err)
+	}
+	got, err := CollectionFromJSON(buf.String())
+	if err != nil {
+		t.Errorf("CollectionFromJSON error: %v", err)
+	}
+	if !reflect.DeepEqual(got.Features, fc.Features) {
+		t.Error("the written collection is not equal to the input")
+	}
+
+	buf.Reset()
+	sw := NewSeqWriter(&buf)
+	for _, f := range fc.Features {
+		err = sw.Write(f)
+		if err != nil {
+			t.Errorf("Write error %v", err)
+		}
+	}
+	assert.Equal(t, bytes.Count(buf.Bytes(), []byte{0x1e}), len(fc.Features))
+	features := readAll(t, NewSeqReader(&buf).Read)
+	if !reflect.DeepEqual(features, fc.Features) {
+		t.Error("the written sequence is not equal to the input")
+	}
+
+	buf.Reset()
+	err = NewCollectionWriter(&buf).Close()
+	if err != nil {
+		t.Errorf("Close error %v", err)
+	}
+	assert.Equal(t, buf.String(), `{"type":"FeatureCollection","features":[]}`)
+}
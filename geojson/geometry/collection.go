@@ -1,15 +1,113 @@
 package geometry
 
-import "github.com/tomchavakis/turf-go/geojson"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tomchavakis/turf-go/geojson"
+)
 
 // Collection type
 // https://tools.ietf.org/html/rfc7946#section-3.1.8
 type Collection struct {
+	Geometries []Geometry `json:"geometries"`
+	// Bbox is the bounding box of the geometry collection.
+	Bbox []float64 `json:"bbox,omitempty"`
+	// ForeignMembers holds the members of the object that aren't defined by the GeoJSON spec.
+	// https://tools.ietf.org/html/rfc7946#section-6.1
+	ForeignMembers map[string]interface{} `json:"-"`
+}
+
+var collectionMembers = []string{"type", "geometries", "bbox"}
+
+type collectionJSON struct {
 	Type       geojson.OBjectType `json:"type"`
 	Geometries []Geometry         `json:"geometries"`
+	Bbox       []float64          `json:"bbox,omitempty"`
 }
 
 // NewGeometryCollection initializes a new instance of GeometryCollection
 func NewGeometryCollection(geometries []Geometry) (*Collection, error) {
-	return &Collection{Geometries: geometries, Type: geojson.GeometryCollection}, nil
+	return &Collection{Geometries: geometries}, nil
+}
+
+// Type returns the GeoJSON type of the Collection.
+func (c Collection) Type() geojson.OBjectType {
+	return geojson.GeometryCollection
+}
+
+// BBox returns the bounding box of all the geometries of the Collection, or nil if they have no positions.
+// Geometries which can't be converted to a typed geometry are skipped.
+func (c Collection) BBox() []float64 {
+	return extendBBox(nil, c.Points())
+}
+
+// Points returns the positions of all the geometries of the Collection.
+// Geometries which can't be converted to a typed geometry are skipped.
+func (c Collection) Points() []Point {
+	points := []Point{}
+	for i := range c.Geometries {
+		o, err := c.Geometries[i].ToObject()
+		if err != nil {
+			continue
+		}
+		points = append(points, o.Points()...)
+	}
+	return points
+}
+
+// Objects returns the typed geometries of the Collection.
+func (c Collection) Objects() ([]Object, error) {
+	objects := make([]Object, 0, len(c.Geometries))
+	for i := range c.Geometries {
+		o, err := c.Geometries[i].ToObject()
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, o)
+	}
+	return objects, nil
+}
+
+// MarshalJSON encodes the Collection as a GeoJSON GeometryCollection.
+func (c Collection) MarshalJSON() ([]byte, error) {
+	cj := collectionJSON{
+		Type:       geojson.GeometryCollection,
+		Geometries: c.Geometries,
+		Bbox:       c.Bbox,
+	}
+	if cj.Geometries == nil {
+		cj.Geometries = []Geometry{}
+	}
+	b, err := json.Marshal(cj)
+	if err != nil {
+		return nil, err
+	}
+	return geojson.AppendForeignMembers(b, c.ForeignMembers, collectionMembers...)
+}
+
+// UnmarshalJSON decodes a GeoJSON GeometryCollection.
+func (c *Collection) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var cj collectionJSON
+	err := json.Unmarshal(data, &cj)
+	if err != nil {
+		return err
+	}
+	if cj.Type != geojson.GeometryCollection {
+		return fmt.Errorf("invalid geometry type %q, expected %q", cj.Type, geojson.GeometryCollection)
+	}
+	fm, err := geojson.ForeignMembers(data, collectionMembers...)
+	if err != nil {
+		return err
+	}
+	*c = Collection{
+		Geometries:     cj.Geometries,
+		Bbox:           cj.Bbox,
+		ForeignMembers: fm,
+	}
+	return nil
 }
@@ -1,6 +1,7 @@
 package geometry
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 
@@ -12,12 +13,23 @@ import (
 type Geometry struct {
 	// GeoJSONType describes the type of GeoJSON Geometry, Feature or FeatureCollection this object is.
 	GeoJSONType geojson.OBjectType `json:"type"`
-	Coordinates interface{}        `json:"coordinates"`
+	// Coordinates holds the positions of the geometry. A decoded geometry holds them as []float64 for a Point,
+	// [][]float64 for a MultiPoint or LineString, [][][]float64 for a MultiLineString or Polygon and
+	// [][][][]float64 for a MultiPolygon.
+	Coordinates interface{} `json:"coordinates"`
+	// Geometries holds the member geometries of a GeometryCollection.
+	Geometries []Geometry `json:"geometries,omitempty"`
+	// Bbox is the bounding box of the geometry.
+	Bbox []float64 `json:"bbox,omitempty"`
+	// ForeignMembers holds the members of the object that aren't defined by the GeoJSON spec.
+	// https://tools.ietf.org/html/rfc7946#section-6.1
+	ForeignMembers map[string]interface{} `json:"-"`
 }
 
+var geometryMembers = []string{"type", "coordinates", "geometries", "bbox"}
+
 // FromJSON returns a new Geometry by passing in a valid JSON string.
 func FromJSON(gjson string) (*Geometry, error) {
-
 	if gjson == "" {
 		return nil, errors.New("input cannot be empty")
 	}
@@ -27,94 +39,235 @@ func FromJSON(gjson string) (*Geometry, error) {
 	if err != nil {
 		return nil, errors.New("cannot decode the input value")
 	}
-
 	return &geometry, nil
+}
 
+// MarshalJSON encodes the Geometry as a GeoJSON geometry object including its bbox and foreign members.
+// A GeometryCollection is encoded with its geometries instead of coordinates.
+func (g Geometry) MarshalJSON() ([]byte, error) {
+	var b []byte
+	var err error
+	if g.GeoJSONType == geojson.GeometryCollection {
+		geometries := g.Geometries
+		if geometries == nil {
+			geometries = []Geometry{}
+		}
+		b, err = json.Marshal(collectionJSON{
+			Type:       g.GeoJSONType,
+			Geometries: geometries,
+			Bbox:       g.Bbox,
+		})
+	} else {
+		b, err = json.Marshal(struct {
+			Type        geojson.OBjectType `json:"type"`
+			Coordinates interface{}        `json:"coordinates"`
+			Bbox        []float64          `json:"bbox,omitempty"`
+		}{
+			Type:        g.GeoJSONType,
+			Coordinates: g.Coordinates,
+			Bbox:        g.Bbox,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return geojson.AppendForeignMembers(b, g.ForeignMembers, geometryMembers...)
 }
 
-// ToPoint converts the Geometry to Point
-func (g *Geometry) ToPoint() (*Point, error) {
-	if g.GeoJSONType == geojson.Point {
+// UnmarshalJSON decodes a GeoJSON geometry object keeping its bbox and foreign members.
+// The coordinates are decoded into the typed slices which correspond to the geometry type.
+func (g *Geometry) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var p struct {
+		Type        geojson.OBjectType `json:"type"`
+		Coordinates json.RawMessage    `json:"coordinates"`
+		Geometries  []Geometry         `json:"geometries"`
+		Bbox        []float64          `json:"bbox"`
+	}
+	err := json.Unmarshal(data, &p)
+	if err != nil {
+		return err
+	}
 
-		var coords []float64
-		ccc, err := json.Marshal(g.Coordinates)
-		if err != nil {
-			return nil, errors.New("cannot marshal object")
+	var coords interface{}
+	switch p.Type {
+	case geojson.Point:
+		coords = &[]float64{}
+	case geojson.MultiPoint, geojson.LineString:
+		coords = &[][]float64{}
+	case geojson.MiltiLineString, geojson.Polygon:
+		coords = &[][][]float64{}
+	case geojson.MultiPolygon:
+		coords = &[][][][]float64{}
+	case geojson.GeometryCollection:
+		// a GeometryCollection has no coordinates but a list of geometries
+	default:
+		return errors.New("invalid geometry type")
+	}
+	if coords != nil {
+		if len(p.Coordinates) == 0 {
+			return errors.New("the coordinates member is missing")
 		}
-		err = json.Unmarshal(ccc, &coords)
+		err = json.Unmarshal(p.Coordinates, coords)
 		if err != nil {
-			return nil, errors.New("cannot unmarshal object")
+			return err
 		}
-		var pos = Point{}
-		pos.Lat = coords[1]
-		pos.Lng = coords[0]
+	}
 
-		return &pos, nil
+	fm, err := geojson.ForeignMembers(data, geometryMembers...)
+	if err != nil {
+		return err
 	}
-	return nil, errors.New("invalid geometry")
+	*g = Geometry{
+		GeoJSONType:    p.Type,
+		Coordinates:    derefCoordinates(coords),
+		Geometries:     p.Geometries,
+		Bbox:           p.Bbox,
+		ForeignMembers: fm,
+	}
+	return nil
 }
 
-// ToMultiPoint converts the Geometry to MultiPoint
-func (g *Geometry) ToMultiPoint() (*MultiPoint, error) {
-	if g.GeoJSONType == geojson.MultiPoint {
-		var m MultiPoint
-		var coords [][]float64
-		ccc, err := json.Marshal(g.Coordinates)
-		if err != nil {
-			return nil, errors.New("cannot marshal object")
+func derefCoordinates(coords interface{}) interface{} {
+	switch c := coords.(type) {
+	case *[]float64:
+		return *c
+	case *[][]float64:
+		return *c
+	case *[][][]float64:
+		return *c
+	case *[][][][]float64:
+		return *c
+	}
+	return coords
+}
+
+// decodeCoordinates copies the Coordinates of the geometry into coords which must be a pointer to a nested float64 slice.
+// Decoded geometries already hold typed coordinates, so the JSON round trip is only needed for coordinates set by hand.
+func (g *Geometry) decodeCoordinates(coords interface{}) error {
+	switch c := coords.(type) {
+	case *[]float64:
+		if v, ok := g.Coordinates.([]float64); ok {
+			*c = v
+			return nil
 		}
-		err = json.Unmarshal(ccc, &coords)
-		if err != nil {
-			return nil, errors.New("cannot unmarshal object")
+	case *[][]float64:
+		if v, ok := g.Coordinates.([][]float64); ok {
+			*c = v
+			return nil
+		}
+	case *[][][]float64:
+		if v, ok := g.Coordinates.([][][]float64); ok {
+			*c = v
+			return nil
 		}
-		for i := 0; i < len(coords); i++ {
-			p := NewPoint(coords[i][1], coords[i][0])
-			m.Coordinates = append(m.Coordinates, *p)
+	case *[][][][]float64:
+		if v, ok := g.Coordinates.([][][][]float64); ok {
+			*c = v
+			return nil
 		}
-		return &m, nil
 	}
 
-	return nil, errors.New("invalid geometry")
+	ccc, err := json.Marshal(g.Coordinates)
+	if err != nil {
+		return errors.New("cannot marshal object")
+	}
+	err = json.Unmarshal(ccc, coords)
+	if err != nil {
+		return errors.New("cannot unmarshal object")
+	}
+	return nil
 }
 
-// ToPolygon convert the Geometry to Polygon
-func (g *Geometry) ToPolygon() (*Polygon, error) {
+// ToObject converts the Geometry to its typed geometry.
+func (g *Geometry) ToObject() (Object, error) {
+	switch g.GeoJSONType {
+	case geojson.Point:
+		return g.ToPoint()
+	case geojson.MultiPoint:
+		return g.ToMultiPoint()
+	case geojson.LineString:
+		return g.ToLineString()
+	case geojson.MiltiLineString:
+		return g.ToMultiLineString()
+	case geojson.Polygon:
+		return g.ToPolygon()
+	case geojson.MultiPolygon:
+		return g.ToMultiPolygon()
+	case geojson.GeometryCollection:
+		return g.ToGeometryCollection()
+	}
+	return nil, errors.New("invalid geometry")
+}
 
-	if g.GeoJSONType == geojson.Polygon {
+// ToGeometryCollection converts a GeometryCollection Geometry to GeometryCollection geometry.
+func (g *Geometry) ToGeometryCollection() (*Collection, error) {
+	if g.GeoJSONType != geojson.GeometryCollection {
+		return nil, errors.New("the feature must be a geometryCollection")
+	}
+	gc, err := NewGeometryCollection(g.Geometries)
+	if err != nil {
+		return nil, errors.New("cannot create a new geometryCollection")
+	}
+	gc.Bbox = g.Bbox
+	return gc, nil
+}
 
-		var coords = []LineString{}
+// ToPoint converts the Geometry to Point
+func (g *Geometry) ToPoint() (*Point, error) {
+	if g.GeoJSONType != geojson.Point {
+		return nil, errors.New("invalid geometry")
+	}
+	var coords []float64
+	err := g.decodeCoordinates(&coords)
+	if err != nil {
+		return nil, err
+	}
+	pos, err := pointFromPosition(coords)
+	if err != nil {
+		return nil, err
+	}
+	return &pos, nil
+}
 
-		var polygonCoordinates [][][]float64
-		ccc, err := json.Marshal(g.Coordinates)
-		if err != nil {
-			return nil, errors.New("cannot marshal object")
-		}
-		err = json.Unmarshal(ccc, &polygonCoordinates)
-		if err != nil {
-			return nil, errors.New("cannot unmarshal object")
-		}
+// ToMultiPoint converts the Geometry to MultiPoint
+func (g *Geometry) ToMultiPoint() (*MultiPoint, error) {
+	if g.GeoJSONType != geojson.MultiPoint {
+		return nil, errors.New("invalid geometry")
+	}
+	var coords [][]float64
+	err := g.decodeCoordinates(&coords)
+	if err != nil {
+		return nil, err
+	}
+	points, err := pointsFromPositions(coords)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiPoint{Coordinates: points}, nil
+}
 
-		for i := 0; i < len(polygonCoordinates); i++ {
-			var posArray = []Point{}
-			for j := 0; j < len(polygonCoordinates[i]); j++ {
-				pos := Point{
-					Lng: polygonCoordinates[i][j][0],
-					Lat: polygonCoordinates[i][j][1],
-				}
-				posArray = append(posArray, pos)
-			}
-			ln := LineString{
-				Coordinates: posArray,
-			}
-			coords = append(coords, ln)
-		}
-		poly, err := NewPolygon(coords)
-		if err != nil {
-			return nil, errors.New("cannot creat a new polygon")
-		}
-		return poly, nil
+// ToPolygon convert the Geometry to Polygon
+func (g *Geometry) ToPolygon() (*Polygon, error) {
+	if g.GeoJSONType != geojson.Polygon {
+		return nil, errors.New("invalid geometry")
 	}
-	return nil, errors.New("invalid geometry")
+	var polygonCoordinates [][][]float64
+	err := g.decodeCoordinates(&polygonCoordinates)
+	if err != nil {
+		return nil, err
+	}
+	coords, err := linesFromPositions(polygonCoordinates)
+	if err != nil {
+		return nil, err
+	}
+	poly, err := NewPolygon(coords)
+	if err != nil {
+		return nil, errors.New("cannot creat a new polygon")
+	}
+	return poly, nil
 }
 
 // ToMultiPolygon converts a MultiPolygon Feature to MultiPolygon geometry.
@@ -123,39 +276,14 @@ func (g *Geometry) ToMultiPolygon() (*MultiPolygon, error) {
 		return nil, errors.New("the feature must be a multiPolygon")
 	}
 	var multiPolygonCoordinates [][][][]float64
-	ccc, err := json.Marshal(g.Coordinates)
+	err := g.decodeCoordinates(&multiPolygonCoordinates)
 	if err != nil {
-		return nil, errors.New("cannot marshal object")
+		return nil, err
 	}
-	err = json.Unmarshal(ccc, &multiPolygonCoordinates)
-
+	polys, err := polygonsFromPositions(multiPolygonCoordinates)
 	if err != nil {
-		return nil, errors.New("cannot marshal object")
-	}
-
-	var polys = []Polygon{}
-	for k := 0; k < len(multiPolygonCoordinates); k++ {
-		var coords = []LineString{}
-		for i := 0; i < len(multiPolygonCoordinates[k]); i++ {
-			var posArray = []Point{}
-			for j := 0; j < len(multiPolygonCoordinates[k][i]); j++ {
-				pos := Point{
-					Lng: multiPolygonCoordinates[k][i][j][0],
-					Lat: multiPolygonCoordinates[k][i][j][1],
-				}
-				posArray = append(posArray, pos)
-			}
-			ln := LineString{
-				Coordinates: posArray,
-			}
-			coords = append(coords, ln)
-		}
-		poly := Polygon{
-			Coordinates: coords,
-		}
-		polys = append(polys, poly)
+		return nil, err
 	}
-
 	poly, err := NewMultiPolygon(polys)
 	if err != nil {
 		return nil, errors.New("cannot creat a new polygon")
@@ -168,26 +296,15 @@ func (g *Geometry) ToLineString() (*LineString, error) {
 	if g.GeoJSONType != geojson.LineString {
 		return nil, errors.New("the feature must be a linestring")
 	}
-
 	var coords [][]float64
-	ccc, err := json.Marshal(g.Coordinates)
+	err := g.decodeCoordinates(&coords)
 	if err != nil {
-		return nil, errors.New("cannot marshal object")
+		return nil, err
 	}
-	err = json.Unmarshal(ccc, &coords)
+	coordinates, err := pointsFromPositions(coords)
 	if err != nil {
-		return nil, errors.New("cannot marshal object")
-	}
-
-	var coordinates []Point
-	for _, coord := range coords {
-		p := Point{
-			Lat: coord[1],
-			Lng: coord[0],
-		}
-		coordinates = append(coordinates, p)
+		return nil, err
 	}
-
 	lineString, err := NewLineString(coordinates)
 	if err != nil {
 		return nil, errors.New("cannot creat a new polygon")
@@ -201,31 +318,14 @@ func (g *Geometry) ToMultiLineString() (*MultiLineString, error) {
 		return nil, errors.New("the feature must be a multiLineString")
 	}
 	var coords [][][]float64
-	ccc, err := json.Marshal(g.Coordinates)
+	err := g.decodeCoordinates(&coords)
 	if err != nil {
-		return nil, errors.New("cannot marshal object")
+		return nil, err
 	}
-
-	err = json.Unmarshal(ccc, &coords)
+	coordinates, err := linesFromPositions(coords)
 	if err != nil {
-		return nil, errors.New("cannot marshal object")
-	}
-
-	var coordinates []LineString
-	for i := 0; i < len(coords); i++ {
-		var ls LineString
-		var points []Point
-		for j := 0; j < len(coords[i]); j++ {
-			p := Point{
-				Lat: coords[i][j][1],
-				Lng: coords[i][j][0],
-			}
-			points = append(points, p)
-		}
-		ls.Coordinates = points
-		coordinates = append(coordinates, ls)
+		return nil, err
 	}
-
 	ml, err := NewMultiLineString(coordinates)
 	if err != nil {
 		return nil, errors.New("can't create a new multiLineString")
@@ -0,0 +1,139 @@
+package geometry
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson"
+)
+
+func TestGeometryJSONRoundTrip(t *testing.T) {
+	tests := map[string]struct {
+		json string
+		obj  interface{}
+	}{
+		"multiPoint": {
+			json: `{"type":"MultiPoint","coordinates":[[100,0],[101,1]]}`,
+			obj:  &MultiPoint{},
+		},
+		"polygon with hole": {
+			json: `{"type":"Polygon","coordinates":[[[100,0],[101,0],[101,1],[100,1],[100,0]],[[100.8,0.8],[100.8,0.2],[100.2,0.2],[100.2,0.8],[100.8,0.8]]]}`,
+			obj:  &Polygon{},
+		},
+		"multiLineString": {
+			json: `{"type":"MultiLineString","coordinates":[[[100,0],[101,1]],[[102,2],[103,3]]]}`,
+			obj:  &MultiLineString{},
+		},
+		"multiPolygon": {
+			json: `{"type":"MultiPolygon","coordinates":[[[[102,2],[103,2],[103,3],[102,3],[102,2]]],[[[100,0],[101,0],[101,1],[100,1],[100,0]]]]}`,
+			obj:  &MultiPolygon{},
+		},
+		"geometry with bbox and foreign members": {
+			json: `{"type":"LineString","coordinates":[[100,0],[101,1]],"bbox":[100,0,101,1],"title":"example"}`,
+			obj:  &Geometry{},
+		},
+		"geometry collection": {
+			json: `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[100,0]},{"type":"LineString","coordinates":[[101,0],[102,1]]}],"bbox":[100,0,102,1]}`,
+			obj:  &Collection{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := json.Unmarshal([]byte(tt.json), tt.obj)
+			if err != nil {
+				t.Errorf("UnmarshalJSON error %v", err)
+				return
+			}
+			b, err := json.Marshal(tt.obj)
+			if err != nil {
+				t.Errorf("MarshalJSON error %v", err)
+				return
+			}
+			var want, got interface{}
+			_ = json.Unmarshal([]byte(tt.json), &want)
+			_ = json.Unmarshal(b, &got)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("round trip got = %s, want %s", b, tt.json)
+			}
+		})
+	}
+}
+
+func TestTypedGeometryBboxAndForeignMembers(t *testing.T) {
+	tests := map[string]struct {
+		json string
+		obj  interface{}
+	}{
+		"point": {
+			json: `{"type":"Point","coordinates":[1,2],"bbox":[1,2,1,2],"foo":1}`,
+			obj:  &Point{},
+		},
+		"multiPoint": {
+			json: `{"type":"MultiPoint","coordinates":[[1,2],[3,4]],"bbox":[1,2,3,4],"foo":1}`,
+			obj:  &MultiPoint{},
+		},
+		"lineString": {
+			json: `{"type":"LineString","coordinates":[[1,2],[3,4]],"bbox":[1,2,3,4],"foo":1}`,
+			obj:  &LineString{},
+		},
+		"multiLineString": {
+			json: `{"type":"MultiLineString","coordinates":[[[1,2],[3,4]],[[5,6],[7,8]]],"bbox":[1,2,7,8],"foo":1}`,
+			obj:  &MultiLineString{},
+		},
+		"polygon": {
+			json: `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]],"bbox":[0,0,1,1],"foo":1}`,
+			obj:  &Polygon{},
+		},
+		"multiPolygon": {
+			json: `{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]]],"bbox":[0,0,1,1],"foo":1}`,
+			obj:  &MultiPolygon{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := json.Unmarshal([]byte(tt.json), tt.obj)
+			if err != nil {
+				t.Fatalf("UnmarshalJSON error %v", err)
+			}
+			v := reflect.ValueOf(tt.obj).Elem()
+			assert.Equal(t, v.FieldByName("Bbox").Len(), 4)
+			assert.Equal(t, v.FieldByName("ForeignMembers").Interface(), map[string]interface{}{"foo": 1.0})
+
+			b, err := json.Marshal(tt.obj)
+			if err != nil {
+				t.Fatalf("MarshalJSON error %v", err)
+			}
+			assert.Equal(t, string(b), tt.json)
+		})
+	}
+}
+
+func TestGeometryForeignMembers(t *testing.T) {
+	g, err := FromJSON(`{"type":"Point","coordinates":[100,0],"bbox":[100,0,100,0],"title":"example"}`)
+	if err != nil {
+		t.Errorf("FromJSON error %v", err)
+	}
+	assert.Equal(t, g.GeoJSONType, geojson.Point)
+	assert.Equal(t, len(g.Bbox), 4)
+	assert.Equal(t, len(g.ForeignMembers), 1)
+	assert.Equal(t, g.ForeignMembers["title"], "example")
+
+	g.ForeignMembers["type"] = "LineString"
+	b, err := json.Marshal(g)
+	if err != nil {
+		t.Errorf("MarshalJSON error %v", err)
+	}
+	assert.Equal(t, string(b), `{"type":"Point","coordinates":[100,0],"bbox":[100,0,100,0],"title":"example"}`)
+}
+
+func TestPolygonUnmarshalJSONInvalidRing(t *testing.T) {
+	var p Polygon
+	err := json.Unmarshal([]byte(`{"type":"Polygon","coordinates":[[[100,0],[101,0],[101,1],[100,1]]]}`), &p)
+	if err == nil {
+		t.Error("an open ring should not be decoded")
+	}
+}
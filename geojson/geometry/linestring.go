@@ -2,11 +2,18 @@ package geometry
 
 import (
 	"errors"
+
+	"github.com/tomchavakis/turf-go/geojson"
 )
 
 // LineString defines the linestring type.
 type LineString struct {
 	Coordinates []Point
+	// Bbox is the bounding box of the LineString.
+	Bbox []float64
+	// ForeignMembers holds the members of the object that aren't defined by the GeoJSON spec.
+	// https://tools.ietf.org/html/rfc7946#section-6.1
+	ForeignMembers map[string]interface{}
 }
 
 // NewLineString initializes a new LineString
@@ -33,3 +40,30 @@ func (l *LineString) IsClosed() bool {
 func (l *LineString) IsLinearRing() bool {
 	return len(l.Coordinates) >= 4 && l.IsClosed()
 }
+
+// MarshalJSON encodes the LineString as a GeoJSON LineString geometry.
+// https://tools.ietf.org/html/rfc7946#section-3.1.4
+func (l LineString) MarshalJSON() ([]byte, error) {
+	return encodeObject(geojson.LineString, positions(l.Coordinates), l.Bbox, l.ForeignMembers)
+}
+
+// UnmarshalJSON decodes a GeoJSON LineString geometry.
+func (l *LineString) UnmarshalJSON(data []byte) error {
+	var coords [][]float64
+	bbox, fm, err := decodeObject(data, geojson.LineString, &coords)
+	if err != nil {
+		return err
+	}
+	points, err := pointsFromPositions(coords)
+	if err != nil {
+		return err
+	}
+	ln, err := NewLineString(points)
+	if err != nil {
+		return err
+	}
+	*l = *ln
+	l.Bbox = bbox
+	l.ForeignMembers = fm
+	return nil
+}
@@ -1,8 +1,11 @@
 package geometry
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
 )
 
 func TestNewLineString(t *testing.T) {
@@ -192,3 +195,25 @@ func TestLineString_IsLinearRing(t *testing.T) {
 		})
 	}
 }
+
+func TestLineString_JSON(t *testing.T) {
+	gjson := `{"type":"LineString","coordinates":[[102,0],[103,1],[104,0],[105,1]]}`
+	var l LineString
+	err := json.Unmarshal([]byte(gjson), &l)
+	if err != nil {
+		t.Errorf("UnmarshalJSON error %v", err)
+	}
+	assert.Equal(t, len(l.Coordinates), 4)
+	assert.Equal(t, l.Coordinates[1], Point{Lat: 1, Lng: 103})
+
+	b, err := json.Marshal(l)
+	if err != nil {
+		t.Errorf("MarshalJSON error %v", err)
+	}
+	assert.Equal(t, string(b), gjson)
+
+	err = json.Unmarshal([]byte(`{"type":"LineString","coordinates":[[102,0]]}`), &l)
+	if err == nil {
+		t.Error("a linestring with one position should not be decoded")
+	}
+}
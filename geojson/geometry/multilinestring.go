@@ -1,19 +1,46 @@
 package geometry
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/tomchavakis/turf-go/geojson"
+)
 
 // MultiLineString type
 //https://tools.ietf.org/html/rfc7946#section-3.1.5
 type MultiLineString struct {
 	Coordinates []LineString
+	// Bbox is the bounding box of the MultiLineString.
+	Bbox []float64
+	// ForeignMembers holds the members of the object that aren't defined by the GeoJSON spec.
+	// https://tools.ietf.org/html/rfc7946#section-6.1
+	ForeignMembers map[string]interface{}
 }
 
 // NewMultiLineString initializes a new MultiLineString
 func NewMultiLineString(coordinates []LineString) (*MultiLineString, error) {
-
 	if len(coordinates) < 2 {
 		return nil, errors.New("according to the GeoJSON v1.0 spec a MultiLineString must have at least two or more positions")
 	}
-
 	return &MultiLineString{Coordinates: coordinates}, nil
 }
+
+// MarshalJSON encodes the MultiLineString as a GeoJSON MultiLineString geometry.
+func (m MultiLineString) MarshalJSON() ([]byte, error) {
+	return encodeObject(geojson.MiltiLineString, linePositions(m.Coordinates), m.Bbox, m.ForeignMembers)
+}
+
+// UnmarshalJSON decodes a GeoJSON MultiLineString geometry.
+func (m *MultiLineString) UnmarshalJSON(data []byte) error {
+	var coords [][][]float64
+	bbox, fm, err := decodeObject(data, geojson.MiltiLineString, &coords)
+	if err != nil {
+		return err
+	}
+	lines, err := linesFromPositions(coords)
+	if err != nil {
+		return err
+	}
+	*m = MultiLineString{Coordinates: lines, Bbox: bbox, ForeignMembers: fm}
+	return nil
+}
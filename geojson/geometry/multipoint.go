@@ -1,19 +1,46 @@
 package geometry
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/tomchavakis/turf-go/geojson"
+)
 
 // MultiPoint defines the MultiPoint type
 //https://tools.ietf.org/html/rfc7946#section-3.1.3
 type MultiPoint struct {
 	Coordinates []Point
+	// Bbox is the bounding box of the MultiPoint.
+	Bbox []float64
+	// ForeignMembers holds the members of the object that aren't defined by the GeoJSON spec.
+	// https://tools.ietf.org/html/rfc7946#section-6.1
+	ForeignMembers map[string]interface{}
 }
 
 // NewMultiPoint initializes a new MultiLineString
 func NewMultiPoint(coordinates []Point) (*MultiPoint, error) {
-
 	if len(coordinates) < 2 {
 		return nil, errors.New("according to the GeoJSON v1.0 spec a MultiLineString must have at least two or more positions")
 	}
-
 	return &MultiPoint{Coordinates: coordinates}, nil
 }
+
+// MarshalJSON encodes the MultiPoint as a GeoJSON MultiPoint geometry.
+func (m MultiPoint) MarshalJSON() ([]byte, error) {
+	return encodeObject(geojson.MultiPoint, positions(m.Coordinates), m.Bbox, m.ForeignMembers)
+}
+
+// UnmarshalJSON decodes a GeoJSON MultiPoint geometry.
+func (m *MultiPoint) UnmarshalJSON(data []byte) error {
+	var coords [][]float64
+	bbox, fm, err := decodeObject(data, geojson.MultiPoint, &coords)
+	if err != nil {
+		return err
+	}
+	points, err := pointsFromPositions(coords)
+	if err != nil {
+		return err
+	}
+	*m = MultiPoint{Coordinates: points, Bbox: bbox, ForeignMembers: fm}
+	return nil
+}
@@ -1,18 +1,49 @@
 package geometry
 
+import "github.com/tomchavakis/turf-go/geojson"
+
 // MultiPolygon defines the MultiPolygon type
 // For type "MultiPolygon", the "coordinates" member is an array of Polygon coordinate arrays.
 //https://tools.ietf.org/html/rfc7946#section-3.1.7
 type MultiPolygon struct {
 	Coordinates []Polygon
+	// Bbox is the bounding box of the MultiPolygon.
+	Bbox []float64
+	// ForeignMembers holds the members of the object that aren't defined by the GeoJSON spec.
+	// https://tools.ietf.org/html/rfc7946#section-6.1
+	ForeignMembers map[string]interface{}
 }
 
 // NewMultiPolygon initialize a new MultiPolygon
 func NewMultiPolygon(coordinates []Polygon) (*MultiPolygon, error) {
-
 	// if len(coordinates) < 2 {
 	// 	return nil, errors.New("according to the GeoJSON v1.0 spec a MultiPolygon must have at least two or more positions")
 	// }
-
 	return &MultiPolygon{Coordinates: coordinates}, nil
 }
+
+// MarshalJSON encodes the MultiPolygon as a GeoJSON MultiPolygon geometry.
+func (m MultiPolygon) MarshalJSON() ([]byte, error) {
+	return encodeObject(geojson.MultiPolygon, polygonPositions(m.Coordinates), m.Bbox, m.ForeignMembers)
+}
+
+// UnmarshalJSON decodes a GeoJSON MultiPolygon geometry.
+func (m *MultiPolygon) UnmarshalJSON(data []byte) error {
+	var coords [][][][]float64
+	bbox, fm, err := decodeObject(data, geojson.MultiPolygon, &coords)
+	if err != nil {
+		return err
+	}
+	polygons, err := polygonsFromPositions(coords)
+	if err != nil {
+		return err
+	}
+	for _, p := range polygons {
+		_, err = NewPolygon(p.Coordinates)
+		if err != nil {
+			return err
+		}
+	}
+	*m = MultiPolygon{Coordinates: polygons, Bbox: bbox, ForeignMembers: fm}
+	return nil
+}
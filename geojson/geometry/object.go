@@ -1,8 +1,235 @@
 package geometry
 
-import "github.com/tomchavakis/turf-go/geojson"
+import (
+	"encoding/json"
+	"errors"
+	"math"
 
-// Object is the base interface for GeometryObject types
-type Object struct {
-	Type geojson.OBjectType
+	"github.com/tomchavakis/turf-go/geojson"
+)
+
+// Object is the base interface for GeometryObject types.
+// It is implemented by Point, MultiPoint, LineString, MultiLineString, Polygon, MultiPolygon and Collection,
+// each of them holding its typed Coordinates.
+type Object interface {
+	// Type returns the GeoJSON type of the geometry.
+	Type() geojson.OBjectType
+	// BBox returns the bounding box of the geometry as [west, south, east, north], or nil if it has no positions.
+	BBox() []float64
+	// Points returns all the positions of the geometry in their order.
+	Points() []Point
+}
+
+// Decode returns the typed geometry of a GeoJSON geometry object.
+// The coordinates are decoded directly into the concrete type without an intermediate representation.
+func Decode(data []byte) (Object, error) {
+	var obj struct {
+		Type geojson.OBjectType `json:"type"`
+	}
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return nil, errors.New("cannot decode the input value")
+	}
+
+	var o Object
+	switch obj.Type {
+	case geojson.Point:
+		o = &Point{}
+	case geojson.MultiPoint:
+		o = &MultiPoint{}
+	case geojson.LineString:
+		o = &LineString{}
+	case geojson.MiltiLineString:
+		o = &MultiLineString{}
+	case geojson.Polygon:
+		o = &Polygon{}
+	case geojson.MultiPolygon:
+		o = &MultiPolygon{}
+	case geojson.GeometryCollection:
+		o = &Collection{}
+	default:
+		return nil, errors.New("invalid geometry type")
+	}
+	err = json.Unmarshal(data, o)
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// NewGeometry initializes a new Geometry from a typed geometry.
+func NewGeometry(o Object) (*Geometry, error) {
+	var coords interface{}
+	switch gtp := o.(type) {
+	case Point:
+		coords = gtp.position()
+	case *Point:
+		coords = gtp.position()
+	case MultiPoint:
+		coords = positions(gtp.Coordinates)
+	case *MultiPoint:
+		coords = positions(gtp.Coordinates)
+	case LineString:
+		coords = positions(gtp.Coordinates)
+	case *LineString:
+		coords = positions(gtp.Coordinates)
+	case MultiLineString:
+		coords = linePositions(gtp.Coordinates)
+	case *MultiLineString:
+		coords = linePositions(gtp.Coordinates)
+	case Polygon:
+		coords = linePositions(gtp.Coordinates)
+	case *Polygon:
+		coords = linePositions(gtp.Coordinates)
+	case MultiPolygon:
+		coords = polygonPositions(gtp.Coordinates)
+	case *MultiPolygon:
+		coords = polygonPositions(gtp.Coordinates)
+	case Collection:
+		return &Geometry{GeoJSONType: geojson.GeometryCollection, Geometries: gtp.Geometries}, nil
+	case *Collection:
+		return &Geometry{GeoJSONType: geojson.GeometryCollection, Geometries: gtp.Geometries}, nil
+	default:
+		return nil, errors.New("unsupported geometry type")
+	}
+	return &Geometry{GeoJSONType: o.Type(), Coordinates: coords}, nil
+}
+
+// Pointer returns a typed geometry passed by value as a pointer to it, so that a type switch on the pointer types
+// matches both forms, and returns any other value unchanged.
+func Pointer(t interface{}) interface{} {
+	switch gtp := t.(type) {
+	case Point:
+		return &gtp
+	case MultiPoint:
+		return &gtp
+	case LineString:
+		return &gtp
+	case MultiLineString:
+		return &gtp
+	case Polygon:
+		return &gtp
+	case MultiPolygon:
+		return &gtp
+	case Collection:
+		return &gtp
+	}
+	return t
+}
+
+// Type returns the GeoJSON type of the Point.
+func (p Point) Type() geojson.OBjectType {
+	return geojson.Point
+}
+
+// BBox returns the bounding box of the Point.
+func (p Point) BBox() []float64 {
+	return []float64{p.Lng, p.Lat, p.Lng, p.Lat}
+}
+
+// Points returns the Point as its only position.
+func (p Point) Points() []Point {
+	return []Point{p}
+}
+
+// Type returns the GeoJSON type of the MultiPoint.
+func (m MultiPoint) Type() geojson.OBjectType {
+	return geojson.MultiPoint
+}
+
+// BBox returns the bounding box of the MultiPoint.
+func (m MultiPoint) BBox() []float64 {
+	return extendBBox(nil, m.Coordinates)
+}
+
+// Points returns the positions of the MultiPoint.
+func (m MultiPoint) Points() []Point {
+	return append([]Point{}, m.Coordinates...)
+}
+
+// Type returns the GeoJSON type of the LineString.
+func (l LineString) Type() geojson.OBjectType {
+	return geojson.LineString
+}
+
+// BBox returns the bounding box of the LineString.
+func (l LineString) BBox() []float64 {
+	return extendBBox(nil, l.Coordinates)
+}
+
+// Points returns the positions of the LineString.
+func (l LineString) Points() []Point {
+	return append([]Point{}, l.Coordinates...)
+}
+
+// Type returns the GeoJSON type of the MultiLineString.
+func (m MultiLineString) Type() geojson.OBjectType {
+	return geojson.MiltiLineString
+}
+
+// BBox returns the bounding box of the MultiLineString.
+func (m MultiLineString) BBox() []float64 {
+	return extendBBox(nil, m.Points())
+}
+
+// Points returns the positions of all the lines of the MultiLineString.
+func (m MultiLineString) Points() []Point {
+	points := []Point{}
+	for _, l := range m.Coordinates {
+		points = append(points, l.Coordinates...)
+	}
+	return points
+}
+
+// Type returns the GeoJSON type of the Polygon.
+func (p Polygon) Type() geojson.OBjectType {
+	return geojson.Polygon
+}
+
+// BBox returns the bounding box of the Polygon.
+func (p Polygon) BBox() []float64 {
+	return extendBBox(nil, p.Points())
+}
+
+// Points returns the positions of all the rings of the Polygon, their closing positions included.
+func (p Polygon) Points() []Point {
+	points := []Point{}
+	for _, r := range p.Coordinates {
+		points = append(points, r.Coordinates...)
+	}
+	return points
+}
+
+// Type returns the GeoJSON type of the MultiPolygon.
+func (m MultiPolygon) Type() geojson.OBjectType {
+	return geojson.MultiPolygon
+}
+
+// BBox returns the bounding box of the MultiPolygon.
+func (m MultiPolygon) BBox() []float64 {
+	return extendBBox(nil, m.Points())
+}
+
+// Points returns the positions of all the rings of the MultiPolygon, their closing positions included.
+func (m MultiPolygon) Points() []Point {
+	points := []Point{}
+	for _, p := range m.Coordinates {
+		points = append(points, p.Points()...)
+	}
+	return points
+}
+
+// extendBBox extends the bounding box to the points, and returns nil for a nil bounding box and no points.
+func extendBBox(bbox []float64, points []Point) []float64 {
+	for _, p := range points {
+		if bbox == nil {
+			bbox = []float64{p.Lng, p.Lat, p.Lng, p.Lat}
+			continue
+		}
+		bbox[0] = math.Min(bbox[0], p.Lng)
+		bbox[1] = math.Min(bbox[1], p.Lat)
+		bbox[2] = math.Max(bbox[2], p.Lng)
+		bbox[3] = math.Max(bbox[3], p.Lat)
+	}
+	return bbox
 }
@@ -0,0 +1,245 @@
+package geometry
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson"
+)
+
+func TestDecode(t *testing.T) {
+	tests := map[string]struct {
+		json    string
+		want    Object
+		wantErr bool
+	}{
+		"point": {
+			json: `{"type":"Point","coordinates":[102,0.5]}`,
+			want: &Point{Lat: 0.5, Lng: 102},
+		},
+		"lineString": {
+			json: `{"type":"LineString","coordinates":[[102,0],[103,1]]}`,
+			want: &LineString{Coordinates: []Point{{Lat: 0, Lng: 102}, {Lat: 1, Lng: 103}}},
+		},
+		"multiPolygon": {
+			json: `{"type":"MultiPolygon","coordinates":[[[[102,2],[103,2],[103,3],[102,2]]]]}`,
+			want: &MultiPolygon{Coordinates: []Polygon{
+				{Coordinates: []LineString{{Coordinates: []Point{{Lat: 2, Lng: 102}, {Lat: 2, Lng: 103}, {Lat: 3, Lng: 103}, {Lat: 2, Lng: 102}}}}},
+			}},
+		},
+		"geometryCollection": {
+			json: `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[102,0.5]}]}`,
+			want: &Collection{Geometries: []Geometry{{GeoJSONType: geojson.Point, Coordinates: []float64{102, 0.5}}}},
+		},
+		"feature": {
+			json:    `{"type":"Feature","geometry":null,"properties":null}`,
+			wantErr: true,
+		},
+		"invalid coordinates": {
+			json:    `{"type":"Polygon","coordinates":[102,0.5]}`,
+			wantErr: true,
+		},
+		"invalid multiPolygon ring": {
+			json:    `{"type":"MultiPolygon","coordinates":[[[[0,0],[1,1]]]]}`,
+			wantErr: true,
+		},
+		"unclosed multiPolygon ring": {
+			json:    `{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,1]]]]}`,
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Decode([]byte(tt.json))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Decode() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometry_ToObject(t *testing.T) {
+	g, err := FromJSON(`{"type":"Polygon","coordinates":[[[100,0],[101,0],[101,1],[100,1],[100,0]]]}`)
+	if err != nil {
+		t.Errorf("FromJSON error %v", err)
+	}
+	if _, ok := g.Coordinates.([][][]float64); !ok {
+		t.Errorf("coordinates should be decoded as [][][]float64, got %T", g.Coordinates)
+	}
+
+	o, err := g.ToObject()
+	if err != nil {
+		t.Errorf("ToObject error %v", err)
+	}
+	assert.Equal(t, o.Type(), geojson.Polygon)
+	if !reflect.DeepEqual(o.BBox(), []float64{100, 0, 101, 1}) {
+		t.Errorf("BBox() = %v", o.BBox())
+	}
+
+	ng, err := NewGeometry(o)
+	if err != nil {
+		t.Errorf("NewGeometry error %v", err)
+	}
+	if !reflect.DeepEqual(ng.Coordinates, g.Coordinates) {
+		t.Errorf("NewGeometry() coordinates = %v, want %v", ng.Coordinates, g.Coordinates)
+	}
+}
+
+func TestGeometry_ToPointFromUntypedCoordinates(t *testing.T) {
+	g := Geometry{
+		GeoJSONType: geojson.Point,
+		Coordinates: []interface{}{23.0, 54.0},
+	}
+	p, err := g.ToPoint()
+	if err != nil {
+		t.Errorf("ToPoint error %v", err)
+	}
+	assert.Equal(t, *p, Point{Lat: 54, Lng: 23})
+}
+
+func TestCollection_BBox(t *testing.T) {
+	gc, err := NewGeometryCollection([]Geometry{
+		{GeoJSONType: geojson.Point, Coordinates: []float64{100, 0}},
+		{GeoJSONType: geojson.LineString, Coordinates: [][]float64{{101, 0}, {102, 1}}},
+	})
+	if err != nil {
+		t.Errorf("NewGeometryCollection error %v", err)
+	}
+	assert.Equal(t, gc.Type(), geojson.GeometryCollection)
+	if !reflect.DeepEqual(gc.BBox(), []float64{100, 0, 102, 1}) {
+		t.Errorf("BBox() = %v", gc.BBox())
+	}
+}
+
+func TestGeometry_ToLineStringZ(t *testing.T) {
+	g, err := FromJSON(`{"type":"LineString","coordinates":[[102,0,10],[103,1,20]]}`)
+	if err != nil {
+		t.Errorf("FromJSON error %v", err)
+	}
+	l, err := g.ToLineString()
+	if err != nil {
+		t.Errorf("ToLineString error %v", err)
+	}
+	assert.Equal(t, *l.Coordinates[0].Alt, 10.0)
+	assert.Equal(t, *l.Coordinates[1].Alt, 20.0)
+
+	ng, err := NewGeometry(l)
+	if err != nil {
+		t.Errorf("NewGeometry error %v", err)
+	}
+	if !reflect.DeepEqual(ng.Coordinates, [][]float64{{102, 0, 10}, {103, 1, 20}}) {
+		t.Errorf("NewGeometry() coordinates = %v", ng.Coordinates)
+	}
+}
+
+func TestGeometry_ToGeometryCollection(t *testing.T) {
+	gjson := `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[100,0]},{"type":"GeometryCollection","geometries":[{"type":"LineString","coordinates":[[101,0],[102,1]]}]}]}`
+	g, err := FromJSON(gjson)
+	if err != nil {
+		t.Errorf("FromJSON error %v", err)
+	}
+	assert.Equal(t, len(g.Geometries), 2)
+
+	b, err := json.Marshal(g)
+	if err != nil {
+		t.Errorf("MarshalJSON error %v", err)
+	}
+	assert.Equal(t, string(b), gjson)
+
+	o, err := g.ToObject()
+	if err != nil {
+		t.Errorf("ToObject error %v", err)
+	}
+	gc, ok := o.(*Collection)
+	if !ok {
+		t.Fatalf("ToObject() = %T, want *Collection", o)
+	}
+	objects, err := gc.Objects()
+	if err != nil {
+		t.Errorf("Objects error %v", err)
+	}
+	assert.Equal(t, objects[1].Type(), geojson.GeometryCollection)
+	if !reflect.DeepEqual(gc.BBox(), []float64{100, 0, 102, 1}) {
+		t.Errorf("BBox() = %v", gc.BBox())
+	}
+
+	ng, err := NewGeometry(gc)
+	if err != nil {
+		t.Errorf("NewGeometry error %v", err)
+	}
+	if !reflect.DeepEqual(ng, &Geometry{GeoJSONType: geojson.GeometryCollection, Geometries: g.Geometries}) {
+		t.Errorf("NewGeometry() = %v", ng)
+	}
+
+	_, err = (&Geometry{GeoJSONType: geojson.Point, Coordinates: []float64{1, 2}}).ToGeometryCollection()
+	if err == nil {
+		t.Error("a point should not be converted to a geometryCollection")
+	}
+}
+
+func TestObject_Points(t *testing.T) {
+	tests := map[string]struct {
+		object Object
+		points []Point
+		bbox   []float64
+	}{
+		"point": {
+			object: Point{Lng: 1, Lat: 2},
+			points: []Point{{Lng: 1, Lat: 2}},
+			bbox:   []float64{1, 2, 1, 2},
+		},
+		"multiLineString": {
+			object: &MultiLineString{Coordinates: []LineString{
+				{Coordinates: []Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}}},
+				{Coordinates: []Point{{Lng: 2, Lat: -1}}},
+			}},
+			points: []Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 2, Lat: -1}},
+			bbox:   []float64{0, -1, 2, 1},
+		},
+		"multiPolygon": {
+			object: MultiPolygon{Coordinates: []Polygon{{Coordinates: []LineString{
+				{Coordinates: []Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}}},
+			}}}},
+			points: []Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}},
+			bbox:   []float64{0, 0, 1, 1},
+		},
+		"geometryCollection": {
+			object: &Collection{Geometries: []Geometry{
+				{GeoJSONType: geojson.Point, Coordinates: []float64{100, 0}},
+				{GeoJSONType: geojson.LineString, Coordinates: [][]float64{{101, 0}, {102, 1}}},
+			}},
+			points: []Point{{Lng: 100, Lat: 0}, {Lng: 101, Lat: 0}, {Lng: 102, Lat: 1}},
+			bbox:   []float64{100, 0, 102, 1},
+		},
+		"empty lineString": {
+			object: &LineString{},
+			points: []Point{},
+		},
+		"empty geometryCollection": {
+			object: Collection{},
+			points: []Point{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.object.Points(), tt.points) {
+				t.Errorf("Points() = %v, want %v", tt.object.Points(), tt.points)
+			}
+			if !reflect.DeepEqual(tt.object.BBox(), tt.bbox) {
+				t.Errorf("BBox() = %v, want %v", tt.object.BBox(), tt.bbox)
+			}
+			// an empty geometry has no bounding box rather than infinite bounds
+			_, err := json.Marshal(tt.object.BBox())
+			if err != nil {
+				t.Errorf("cannot encode the bounding box: %v", err)
+			}
+		})
+	}
+}
@@ -1,9 +1,20 @@
 package geometry
 
+import "github.com/tomchavakis/turf-go/geojson"
+
 // Point represents a geolocation using ESPG-900913/(ESPG-3875) Projection
 type Point struct {
 	Lat float64
 	Lng float64
+	// Alt is the optional altitude or elevation of the point, the third element of a GeoJSON position.
+	Alt *float64
+	// M is the optional measure of the point, e.g. a timestamp or a distance along a route.
+	M *float64
+	// Bbox is the bounding box of the Point geometry.
+	Bbox []float64
+	// ForeignMembers holds the members of the object that aren't defined by the GeoJSON spec.
+	// https://tools.ietf.org/html/rfc7946#section-6.1
+	ForeignMembers map[string]interface{}
 }
 
 // NewPoint initializes a new Point
@@ -13,3 +24,45 @@ func NewPoint(lat float64, lng float64) *Point {
 		Lng: lng,
 	}
 }
+
+// NewPointZ initializes a new Point with an altitude
+func NewPointZ(lat float64, lng float64, alt float64) *Point {
+	return &Point{
+		Lat: lat,
+		Lng: lng,
+		Alt: &alt,
+	}
+}
+
+// HasAlt reports whether the point has an altitude
+func (p Point) HasAlt() bool {
+	return p.Alt != nil
+}
+
+// HasM reports whether the point has a measure
+func (p Point) HasM() bool {
+	return p.M != nil
+}
+
+// MarshalJSON encodes the Point as a GeoJSON Point geometry.
+// https://tools.ietf.org/html/rfc7946#section-3.1.2
+func (p Point) MarshalJSON() ([]byte, error) {
+	return encodeObject(geojson.Point, p.position(), p.Bbox, p.ForeignMembers)
+}
+
+// UnmarshalJSON decodes a GeoJSON Point geometry.
+func (p *Point) UnmarshalJSON(data []byte) error {
+	var coords []float64
+	bbox, fm, err := decodeObject(data, geojson.Point, &coords)
+	if err != nil {
+		return err
+	}
+	pt, err := pointFromPosition(coords)
+	if err != nil {
+		return err
+	}
+	pt.Bbox = bbox
+	pt.ForeignMembers = fm
+	*p = pt
+	return nil
+}
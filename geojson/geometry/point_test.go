@@ -1,8 +1,11 @@
 package geometry
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
 )
 
 func TestNewPoint(t *testing.T) {
@@ -33,3 +36,85 @@ func TestNewPoint(t *testing.T) {
 		})
 	}
 }
+
+func TestPoint_MarshalJSON(t *testing.T) {
+	p := NewPoint(35.55, 23.44)
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Errorf("MarshalJSON error %v", err)
+	}
+	assert.Equal(t, string(b), `{"type":"Point","coordinates":[23.44,35.55]}`)
+}
+
+func TestPoint_UnmarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		json    string
+		want    Point
+		wantErr bool
+	}{
+		"happy path": {
+			json: `{"type":"Point","coordinates":[23.44,35.55]}`,
+			want: Point{Lat: 35.55, Lng: 23.44},
+		},
+		"invalid type": {
+			json:    `{"type":"LineString","coordinates":[[23.44,35.55],[23.44,35.55]]}`,
+			wantErr: true,
+		},
+		"invalid position": {
+			json:    `{"type":"Point","coordinates":[23.44]}`,
+			wantErr: true,
+		},
+		"missing coordinates": {
+			json:    `{"type":"Point"}`,
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var p Point
+			err := json.Unmarshal([]byte(tt.json), &p)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(p, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", p, tt.want)
+			}
+		})
+	}
+}
+
+func TestPointZ_JSON(t *testing.T) {
+	p := NewPointZ(35.55, 23.44, 120.5)
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Errorf("MarshalJSON error %v", err)
+	}
+	assert.Equal(t, string(b), `{"type":"Point","coordinates":[23.44,35.55,120.5]}`)
+
+	var got Point
+	err = json.Unmarshal(b, &got)
+	if err != nil {
+		t.Errorf("UnmarshalJSON error %v", err)
+	}
+	if !reflect.DeepEqual(&got, p) {
+		t.Errorf("UnmarshalJSON() got = %v, want %v", got, p)
+	}
+	assert.Equal(t, got.HasAlt(), true)
+	assert.Equal(t, got.HasM(), false)
+
+	err = json.Unmarshal([]byte(`{"type":"Point","coordinates":[23.44,35.55,120.5,7]}`), &got)
+	if err != nil {
+		t.Errorf("UnmarshalJSON error %v", err)
+	}
+	assert.Equal(t, *got.Alt, 120.5)
+	assert.Equal(t, *got.M, 7.0)
+}
+
+func TestPosition_ToPoint(t *testing.T) {
+	alt := 12.0
+	p := NewPosition(&alt, 35.55, 23.44).ToPoint()
+	assert.Equal(t, p.Lat, 35.55)
+	assert.Equal(t, p.Lng, 23.44)
+	assert.Equal(t, *p.Alt, 12.0)
+}
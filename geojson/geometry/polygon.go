@@ -1,11 +1,20 @@
 package geometry
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/tomchavakis/turf-go/geojson"
+)
 
 // Polygon defines a polygon type
 // https://tools.ietf.org/html/rfc7946#section-3.1.6
 type Polygon struct {
 	Coordinates []LineString
+	// Bbox is the bounding box of the Polygon.
+	Bbox []float64
+	// ForeignMembers holds the members of the object that aren't defined by the GeoJSON spec.
+	// https://tools.ietf.org/html/rfc7946#section-6.1
+	ForeignMembers map[string]interface{}
 }
 
 // NewPolygon initializes a new instance of a Polygon
@@ -24,3 +33,29 @@ func NewPolygon(coordinates []LineString) (*Polygon, error) {
 
 	return &Polygon{Coordinates: coordinates}, nil
 }
+
+// MarshalJSON encodes the Polygon as a GeoJSON Polygon geometry.
+func (p Polygon) MarshalJSON() ([]byte, error) {
+	return encodeObject(geojson.Polygon, linePositions(p.Coordinates), p.Bbox, p.ForeignMembers)
+}
+
+// UnmarshalJSON decodes a GeoJSON Polygon geometry.
+func (p *Polygon) UnmarshalJSON(data []byte) error {
+	var coords [][][]float64
+	bbox, fm, err := decodeObject(data, geojson.Polygon, &coords)
+	if err != nil {
+		return err
+	}
+	rings, err := linesFromPositions(coords)
+	if err != nil {
+		return err
+	}
+	poly, err := NewPolygon(rings)
+	if err != nil {
+		return err
+	}
+	*p = *poly
+	p.Bbox = bbox
+	p.ForeignMembers = fm
+	return nil
+}
@@ -1,5 +1,13 @@
 package geometry
 
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/tomchavakis/turf-go/geojson"
+)
+
 // Position is the fundamental geometry construct, consisting of Latitude, Longtitude and Altitude
 type Position struct {
 	Altitude  *float64
@@ -17,5 +25,144 @@ func (p *Position) ToPoint() Point {
 	return Point{
 		Lat: p.Latitude,
 		Lng: p.Longitude,
+		Alt: p.Altitude,
+	}
+}
+
+// position returns the GeoJSON position of the point which is an array of [longitude, latitude, altitude, measure].
+// GeoJSON has no position with a measure but without an altitude, so the measure is only kept alongside the altitude.
+// https://tools.ietf.org/html/rfc7946#section-3.1.1
+func (p Point) position() []float64 {
+	if p.Alt == nil {
+		return []float64{p.Lng, p.Lat}
+	}
+	if p.M == nil {
+		return []float64{p.Lng, p.Lat, *p.Alt}
+	}
+	return []float64{p.Lng, p.Lat, *p.Alt, *p.M}
+}
+
+func pointFromPosition(c []float64) (Point, error) {
+	if len(c) < 2 {
+		return Point{}, errors.New("a position must have two or more elements")
+	}
+	p := Point{Lat: c[1], Lng: c[0]}
+	if len(c) > 2 {
+		alt := c[2]
+		p.Alt = &alt
+	}
+	if len(c) > 3 {
+		m := c[3]
+		p.M = &m
+	}
+	return p, nil
+}
+
+func positions(points []Point) [][]float64 {
+	coords := make([][]float64, 0, len(points))
+	for _, p := range points {
+		coords = append(coords, p.position())
+	}
+	return coords
+}
+
+func pointsFromPositions(c [][]float64) ([]Point, error) {
+	points := make([]Point, 0, len(c))
+	for _, pos := range c {
+		p, err := pointFromPosition(pos)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
+
+func linePositions(lines []LineString) [][][]float64 {
+	coords := make([][][]float64, 0, len(lines))
+	for _, l := range lines {
+		coords = append(coords, positions(l.Coordinates))
+	}
+	return coords
+}
+
+func linesFromPositions(c [][][]float64) ([]LineString, error) {
+	lines := make([]LineString, 0, len(c))
+	for _, pos := range c {
+		points, err := pointsFromPositions(pos)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, LineString{Coordinates: points})
+	}
+	return lines, nil
+}
+
+func polygonPositions(polygons []Polygon) [][][][]float64 {
+	coords := make([][][][]float64, 0, len(polygons))
+	for _, p := range polygons {
+		coords = append(coords, linePositions(p.Coordinates))
+	}
+	return coords
+}
+
+func polygonsFromPositions(c [][][][]float64) ([]Polygon, error) {
+	polygons := make([]Polygon, 0, len(c))
+	for _, pos := range c {
+		rings, err := linesFromPositions(pos)
+		if err != nil {
+			return nil, err
+		}
+		polygons = append(polygons, Polygon{Coordinates: rings})
+	}
+	return polygons, nil
+}
+
+var objectMembers = []string{"type", "coordinates", "bbox"}
+
+// decodeObject decodes a GeoJSON geometry object of the expected type into its coordinates and returns its bbox and
+// foreign members.
+func decodeObject(data []byte, expected geojson.OBjectType, coordinates interface{}) ([]float64, map[string]interface{}, error) {
+	var obj struct {
+		Type        geojson.OBjectType `json:"type"`
+		Coordinates json.RawMessage    `json:"coordinates"`
+		Bbox        []float64          `json:"bbox"`
+	}
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	if obj.Type != expected {
+		return nil, nil, fmt.Errorf("invalid geometry type %q, expected %q", obj.Type, expected)
+	}
+	if len(obj.Coordinates) == 0 {
+		return nil, nil, errors.New("the coordinates member is missing")
+	}
+	err = json.Unmarshal(obj.Coordinates, coordinates)
+	if err != nil {
+		return nil, nil, err
+	}
+	fm, err := geojson.ForeignMembers(data, objectMembers...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return obj.Bbox, fm, nil
+}
+
+// encodeObject encodes the coordinates as a GeoJSON geometry object of the given type with its bbox and foreign
+// members.
+func encodeObject(tp geojson.OBjectType, coordinates interface{}, bbox []float64, fm map[string]interface{}) ([]byte, error) {
+	b, err := json.Marshal(struct {
+		Type        geojson.OBjectType `json:"type"`
+		Coordinates interface{}        `json:"coordinates"`
+		Bbox        []float64          `json:"bbox,omitempty"`
+	}{
+		Type:        tp,
+		Coordinates: coordinates,
+		Bbox:        bbox,
+	})
+	if err != nil {
+		return nil, err
 	}
+	return geojson.AppendForeignMembers(b, fm, objectMembers...)
 }
@@ -0,0 +1,77 @@
+package geojson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// AppendForeignMembers adds the foreign members to an already encoded JSON object.
+// Members that share a name with one of the reserved members are skipped so they can't override the GeoJSON members.
+// https://tools.ietf.org/html/rfc7946#section-6.1
+func AppendForeignMembers(object []byte, members map[string]interface{}, reserved ...string) ([]byte, error) {
+	fm := make(map[string]interface{}, len(members))
+	for k, v := range members {
+		if !contains(reserved, k) {
+			fm[k] = v
+		}
+	}
+	if len(fm) == 0 {
+		return object, nil
+	}
+
+	object = bytes.TrimSpace(object)
+	if len(object) < 2 || object[len(object)-1] != '}' {
+		return nil, errors.New("cannot append foreign members to a non object value")
+	}
+
+	b, err := json.Marshal(fm)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.Write(object[:len(object)-1])
+	if len(bytes.TrimSpace(object[1:len(object)-1])) > 0 {
+		buf.WriteByte(',')
+	}
+	buf.Write(b[1:])
+	return buf.Bytes(), nil
+}
+
+// ForeignMembers decodes a JSON object and returns every member which is not one of the reserved members.
+// It returns nil if the object doesn't have any foreign members.
+// https://tools.ietf.org/html/rfc7946#section-6.1
+func ForeignMembers(object []byte, reserved ...string) (map[string]interface{}, error) {
+	var raw map[string]json.RawMessage
+	err := json.Unmarshal(object, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var fm map[string]interface{}
+	for k, v := range raw {
+		if contains(reserved, k) {
+			continue
+		}
+		var value interface{}
+		err = json.Unmarshal(v, &value)
+		if err != nil {
+			return nil, err
+		}
+		if fm == nil {
+			fm = make(map[string]interface{})
+		}
+		fm[k] = value
+	}
+	return fm, nil
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
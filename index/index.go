@@ -0,0 +1,354 @@
+package index
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/tomchavakis/turf-go/conversions"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// DefaultNodeSize is the number of entries of every node of the tree used when the node size isn't set.
+const DefaultNodeSize = 16
+
+// Index is a static R-tree of bounding boxes which is packed using the Hilbert curve.
+// The boxes are added with Add and the tree is built by Finish, after which it can't be modified.
+// It is a port of https://github.com/mourner/flatbush
+type Index struct {
+	numItems int
+	nodeSize int
+	// boxes holds the minX, minY, maxX, maxY of every item followed by the ones of the nodes of each level of the tree.
+	boxes []float64
+	// indices holds the index of every item and the position in boxes of the first child of every node.
+	indices     []int
+	levelBounds []int
+	pos         int
+	minX        float64
+	minY        float64
+	maxX        float64
+	maxY        float64
+	finished    bool
+}
+
+// New initializes an Index for numItems boxes with nodeSize entries per node.
+// A nodeSize lower than 2 uses DefaultNodeSize.
+func New(numItems int, nodeSize int) (*Index, error) {
+	if numItems < 0 {
+		return nil, errors.New("the number of items cannot be negative")
+	}
+	if nodeSize < 2 {
+		nodeSize = DefaultNodeSize
+	}
+
+	// calculate the total number of nodes in the R-tree to allocate space for
+	// and the index of each tree level, used in search
+	n := numItems
+	numNodes := n
+	levelBounds := []int{n * 4}
+	if n > 0 {
+		for {
+			n = (n + nodeSize - 1) / nodeSize
+			numNodes += n
+			levelBounds = append(levelBounds, numNodes*4)
+			if n == 1 {
+				break
+			}
+		}
+	}
+
+	return &Index{
+		numItems:    numItems,
+		nodeSize:    nodeSize,
+		boxes:       make([]float64, numNodes*4),
+		indices:     make([]int, numNodes),
+		levelBounds: levelBounds,
+		minX:        math.Inf(1),
+		minY:        math.Inf(1),
+		maxX:        math.Inf(-1),
+		maxY:        math.Inf(-1),
+	}, nil
+}
+
+// FromFeatures returns an Index of the bounding boxes of the features of the collection.
+// The index of every item is the position of the feature in the collection. Bounding boxes wider than 180 degrees,
+// as the ones of geometries crossing the antimeridian, are extended to all longitudes.
+func FromFeatures(fc feature.Collection, nodeSize int) (*Index, error) {
+	idx, err := New(len(fc.Features), nodeSize)
+	if err != nil {
+		return nil, err
+	}
+	for i := range fc.Features {
+		bbox, err := measurement.BBox(&fc.Features[i])
+		if err != nil {
+			return nil, err
+		}
+		if bbox[2]-bbox[0] > 180 {
+			bbox[0] = math.Min(bbox[0], -180)
+			bbox[2] = math.Max(bbox[2], 180)
+		}
+		idx.Add(bbox[0], bbox[1], bbox[2], bbox[3])
+	}
+	return idx, idx.Finish()
+}
+
+// FromPoints returns an Index of the points. The index of every item is the position of the point in the list.
+func FromPoints(points []geometry.Point, nodeSize int) (*Index, error) {
+	idx, err := New(len(points), nodeSize)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range points {
+		idx.Add(p.Lng, p.Lat, p.Lng, p.Lat)
+	}
+	return idx, idx.Finish()
+}
+
+// NumItems returns the number of boxes of the index.
+func (idx *Index) NumItems() int {
+	return idx.numItems
+}
+
+// Add adds a bounding box to the index and returns its index. Add must be called exactly numItems times before Finish.
+func (idx *Index) Add(minX float64, minY float64, maxX float64, maxY float64) int {
+	index := idx.pos >> 2
+	if idx.finished || index >= idx.numItems {
+		return -1
+	}
+	idx.indices[index] = index
+	idx.boxes[idx.pos] = minX
+	idx.boxes[idx.pos+1] = minY
+	idx.boxes[idx.pos+2] = maxX
+	idx.boxes[idx.pos+3] = maxY
+	idx.pos += 4
+
+	idx.minX = math.Min(idx.minX, minX)
+	idx.minY = math.Min(idx.minY, minY)
+	idx.maxX = math.Max(idx.maxX, maxX)
+	idx.maxY = math.Max(idx.maxY, maxY)
+	return index
+}
+
+// Finish sorts the items by the Hilbert value of their centers and builds the tree.
+func (idx *Index) Finish() error {
+	if idx.finished {
+		return errors.New("the index is already finished")
+	}
+	if idx.pos>>2 != idx.numItems {
+		return errors.New("the number of added items doesn't match the size of the index")
+	}
+	idx.finished = true
+	if idx.numItems == 0 {
+		return nil
+	}
+
+	if idx.numItems <= idx.nodeSize {
+		// only one node, skip sorting and just fill the root box
+		idx.indices[idx.pos>>2] = 0
+		idx.boxes[idx.pos] = idx.minX
+		idx.boxes[idx.pos+1] = idx.minY
+		idx.boxes[idx.pos+2] = idx.maxX
+		idx.boxes[idx.pos+3] = idx.maxY
+		return nil
+	}
+
+	width := idx.maxX - idx.minX
+	if width == 0 {
+		width = 1
+	}
+	height := idx.maxY - idx.minY
+	if height == 0 {
+		height = 1
+	}
+	hilbertMax := float64(1<<16 - 1)
+
+	// map item centers into Hilbert coordinate space and calculate Hilbert values
+	s := &hilbertSorter{values: make([]uint32, idx.numItems), boxes: idx.boxes, indices: idx.indices}
+	for i := 0; i < idx.numItems; i++ {
+		pos := 4 * i
+		x := uint32(hilbertMax * ((idx.boxes[pos]+idx.boxes[pos+2])/2 - idx.minX) / width)
+		y := uint32(hilbertMax * ((idx.boxes[pos+1]+idx.boxes[pos+3])/2 - idx.minY) / height)
+		s.values[i] = hilbert(x, y)
+	}
+	sort.Sort(s)
+
+	// generate nodes at each tree level, bottom-up
+	pos := 0
+	for i := 0; i < len(idx.levelBounds)-1; i++ {
+		end := idx.levelBounds[i]
+		for pos < end {
+			nodeIndex := pos
+			nodeMinX, nodeMinY, nodeMaxX, nodeMaxY := idx.boxes[pos], idx.boxes[pos+1], idx.boxes[pos+2], idx.boxes[pos+3]
+			pos += 4
+			for j := 1; j < idx.nodeSize && pos < end; j++ {
+				nodeMinX = math.Min(nodeMinX, idx.boxes[pos])
+				nodeMinY = math.Min(nodeMinY, idx.boxes[pos+1])
+				nodeMaxX = math.Max(nodeMaxX, idx.boxes[pos+2])
+				nodeMaxY = math.Max(nodeMaxY, idx.boxes[pos+3])
+				pos += 4
+			}
+			idx.indices[idx.pos>>2] = nodeIndex
+			idx.boxes[idx.pos] = nodeMinX
+			idx.boxes[idx.pos+1] = nodeMinY
+			idx.boxes[idx.pos+2] = nodeMaxX
+			idx.boxes[idx.pos+3] = nodeMaxY
+			idx.pos += 4
+		}
+	}
+	return nil
+}
+
+// Search returns the indices of the items whose bounding boxes intersect the given bounding box.
+func (idx *Index) Search(minX float64, minY float64, maxX float64, maxY float64) []int {
+	var results []int
+	idx.search(minX, minY, maxX, maxY, func(index int) bool {
+		results = append(results, index)
+		return true
+	})
+	return results
+}
+
+// Collides returns true if the bounding box of any item intersects the given bounding box.
+func (idx *Index) Collides(minX float64, minY float64, maxX float64, maxY float64) bool {
+	found := false
+	idx.search(minX, minY, maxX, maxY, func(index int) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// search calls visit for every item intersecting the bounding box until it returns false.
+func (idx *Index) search(minX float64, minY float64, maxX float64, maxY float64, visit func(int) bool) {
+	if !idx.finished || idx.numItems == 0 {
+		return
+	}
+
+	nodeIndex := len(idx.boxes) - 4
+	var queue []int
+	for {
+		// find the end index of the node
+		end := min(nodeIndex+idx.nodeSize*4, idx.upperBound(nodeIndex))
+
+		// search through child nodes
+		for pos := nodeIndex; pos < end; pos += 4 {
+			// check if node bbox intersects with query bbox
+			if maxX < idx.boxes[pos] || maxY < idx.boxes[pos+1] || minX > idx.boxes[pos+2] || minY > idx.boxes[pos+3] {
+				continue
+			}
+			index := idx.indices[pos>>2]
+			if nodeIndex >= idx.numItems*4 {
+				// node, add it to the search queue
+				queue = append(queue, index)
+			} else if !visit(index) {
+				return
+			}
+		}
+
+		if len(queue) == 0 {
+			return
+		}
+		nodeIndex = queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+	}
+}
+
+// Neighbors returns the indices of the k items closest to the point in the plane of the coordinates, ordered by
+// distance. Only items within maxDistance are returned, a k or maxDistance which isn't positive means no limit.
+func (idx *Index) Neighbors(x float64, y float64, k int, maxDistance float64) []int {
+	maxDist := math.Inf(1)
+	if maxDistance > 0 {
+		maxDist = maxDistance * maxDistance
+	}
+	return idx.neighbors(k, maxDist, func(minX, minY, maxX, maxY float64) float64 {
+		dx := axisDist(x, minX, maxX)
+		dy := axisDist(y, minY, maxY)
+		return dx*dx + dy*dy
+	})
+}
+
+// GeoNeighbors returns the indices of the k items closest to the point by great circle distance, ordered by
+// distance. The coordinates of the items must be longitudes and latitudes.
+// Only items within maxDistance in the given units are returned, a k or maxDistance which isn't positive means no limit.
+// It is a port of https://github.com/mourner/geoflatbush
+func (idx *Index) GeoNeighbors(p geometry.Point, k int, maxDistance float64, units string) ([]int, error) {
+	maxDist := 1.0
+	if maxDistance > 0 {
+		radians, err := conversions.LengthToRadians(maxDistance, units)
+		if err != nil {
+			return nil, err
+		}
+		maxDist = haverSin(radians)
+	}
+	lat := conversions.DegreesToRadians(p.Lat)
+	cosLat, sinLat := math.Cos(lat), math.Sin(lat)
+	return idx.neighbors(k, maxDist, func(minLng, minLat, maxLng, maxLat float64) float64 {
+		return boxDist(p.Lng, p.Lat, cosLat, sinLat, minLng, minLat, maxLng, maxLat)
+	}), nil
+}
+
+// neighbors returns the closest items using dist as the lower bound of the distance to the items within a bounding box.
+func (idx *Index) neighbors(k int, maxDist float64, dist func(minX, minY, maxX, maxY float64) float64) []int {
+	if !idx.finished || idx.numItems == 0 {
+		return nil
+	}
+
+	var results []int
+	q := &queue{}
+	nodeIndex := len(idx.boxes) - 4
+	for {
+		// find the end index of the node
+		end := min(nodeIndex+idx.nodeSize*4, idx.upperBound(nodeIndex))
+
+		// add child nodes to the queue
+		for pos := nodeIndex; pos < end; pos += 4 {
+			d := dist(idx.boxes[pos], idx.boxes[pos+1], idx.boxes[pos+2], idx.boxes[pos+3])
+			if d > maxDist {
+				continue
+			}
+			heap.Push(q, queueItem{index: idx.indices[pos>>2], leaf: nodeIndex < idx.numItems*4, dist: d})
+		}
+
+		// pop items from the queue while they are closer than any node left in it
+		for q.Len() > 0 && (*q)[0].leaf {
+			item := heap.Pop(q).(queueItem)
+			results = append(results, item.index)
+			if k > 0 && len(results) == k {
+				return results
+			}
+		}
+
+		if q.Len() == 0 {
+			return results
+		}
+		nodeIndex = heap.Pop(q).(queueItem).index
+	}
+}
+
+// upperBound returns the bound of the level of the tree the node belongs to.
+func (idx *Index) upperBound(nodeIndex int) int {
+	i := sort.Search(len(idx.levelBounds), func(i int) bool {
+		return idx.levelBounds[i] > nodeIndex
+	})
+	return idx.levelBounds[i]
+}
+
+func axisDist(k float64, min float64, max float64) float64 {
+	if k < min {
+		return min - k
+	}
+	if k <= max {
+		return 0
+	}
+	return k - max
+}
+
+func min(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
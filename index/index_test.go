@@ -0,0 +1,202 @@
+package index
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/measurement"
+	"github.com/tomchavakis/turf-go/utils"
+)
+
+const AreaFeatureCollection = "../test-data/area-feature-collection.json"
+
+func randomBoxes(n int) [][4]float64 {
+	r := rand.New(rand.NewSource(42))
+	boxes := make([][4]float64, n)
+	for i := range boxes {
+		x := r.Float64()*360 - 180
+		y := r.Float64()*170 - 85
+		boxes[i] = [4]float64{x, y, x + r.Float64()*5, y + r.Float64()*5}
+	}
+	return boxes
+}
+
+func newIndex(t *testing.T, boxes [][4]float64, nodeSize int) *Index {
+	idx, err := New(len(boxes), nodeSize)
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	for i, b := range boxes {
+		assert.Equal(t, idx.Add(b[0], b[1], b[2], b[3]), i)
+	}
+	err = idx.Finish()
+	if err != nil {
+		t.Fatalf("Finish error: %v", err)
+	}
+	return idx
+}
+
+func TestSearch(t *testing.T) {
+	boxes := randomBoxes(1000)
+	tests := map[string]struct {
+		boxes    [][4]float64
+		nodeSize int
+	}{
+		"single node": {
+			boxes:    boxes[:10],
+			nodeSize: 16,
+		},
+		"default node size": {
+			boxes: boxes,
+		},
+		"small nodes": {
+			boxes:    boxes,
+			nodeSize: 4,
+		},
+	}
+	query := [4]float64{-40, -20, 40, 20}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			idx := newIndex(t, tt.boxes, tt.nodeSize)
+
+			var want []int
+			for i, b := range tt.boxes {
+				if b[0] <= query[2] && b[1] <= query[3] && b[2] >= query[0] && b[3] >= query[1] {
+					want = append(want, i)
+				}
+			}
+			got := idx.Search(query[0], query[1], query[2], query[3])
+			sort.Ints(got)
+			assert.Equal(t, reflect.DeepEqual(got, want), true)
+			assert.Equal(t, idx.Collides(query[0], query[1], query[2], query[3]), len(want) > 0)
+		})
+	}
+}
+
+func TestEmptyIndex(t *testing.T) {
+	idx := newIndex(t, nil, 0)
+	assert.Equal(t, len(idx.Search(-180, -90, 180, 90)), 0)
+	assert.Equal(t, len(idx.Neighbors(0, 0, 1, 0)), 0)
+}
+
+func TestFinish(t *testing.T) {
+	idx, err := New(2, 0)
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	idx.Add(0, 0, 1, 1)
+	err = idx.Finish()
+	if err == nil {
+		t.Errorf("Finish expected an error for a missing item")
+	}
+	idx.Add(1, 1, 2, 2)
+	assert.Equal(t, idx.Add(2, 2, 3, 3), -1)
+	err = idx.Finish()
+	if err != nil {
+		t.Errorf("Finish error: %v", err)
+	}
+	assert.Equal(t, idx.NumItems(), 2)
+}
+
+func TestNeighbors(t *testing.T) {
+	boxes := randomBoxes(1000)
+	idx := newIndex(t, boxes, 0)
+
+	got := idx.Neighbors(10, 10, 5, 0)
+	assert.Equal(t, len(got), 5)
+
+	dist := func(i int) float64 {
+		dx := axisDist(10, boxes[i][0], boxes[i][2])
+		dy := axisDist(10, boxes[i][1], boxes[i][3])
+		return dx*dx + dy*dy
+	}
+	all := make([]int, len(boxes))
+	for i := range all {
+		all[i] = i
+	}
+	sort.Slice(all, func(i, j int) bool { return dist(all[i]) < dist(all[j]) })
+	for i, g := range got {
+		assert.Equal(t, dist(g), dist(all[i]))
+	}
+
+	within := idx.Neighbors(10, 10, 0, 10)
+	for _, i := range within {
+		if dist(i) > 100 {
+			t.Errorf("Neighbors returned an item farther than the maximum distance")
+		}
+	}
+}
+
+func TestGeoNeighbors(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	points := make([]geometry.Point, 2000)
+	for i := range points {
+		points[i] = geometry.Point{Lng: r.Float64()*360 - 180, Lat: r.Float64()*170 - 85}
+	}
+	idx, err := FromPoints(points, 0)
+	if err != nil {
+		t.Fatalf("FromPoints error: %v", err)
+	}
+
+	refs := []geometry.Point{{Lng: 23.72, Lat: 37.98}, {Lng: 179.9, Lat: -16.5}, {Lng: -179.9, Lat: 70}}
+	for _, ref := range refs {
+		got, err := idx.GeoNeighbors(ref, 10, 0, constants.UnitKilometers)
+		if err != nil {
+			t.Errorf("GeoNeighbors error: %v", err)
+		}
+
+		dist := func(i int) float64 {
+			d, _ := measurement.PointDistance(ref, points[i], constants.UnitKilometers)
+			return d
+		}
+		all := make([]int, len(points))
+		for i := range all {
+			all[i] = i
+		}
+		sort.Slice(all, func(i, j int) bool { return dist(all[i]) < dist(all[j]) })
+		assert.Equal(t, reflect.DeepEqual(got, all[:10]), true)
+
+		within, err := idx.GeoNeighbors(ref, 0, 1000, constants.UnitKilometers)
+		if err != nil {
+			t.Errorf("GeoNeighbors error: %v", err)
+		}
+		count := 0
+		for count < len(all) && dist(all[count]) <= 1000 {
+			count++
+		}
+		assert.Equal(t, reflect.DeepEqual(within, all[:count]), true)
+	}
+}
+
+func TestFromFeatures(t *testing.T) {
+	gjson, err := utils.LoadJSONFixture(AreaFeatureCollection)
+	if err != nil {
+		t.Fatalf("cannot load fixture: %v", err)
+	}
+	fc, err := feature.CollectionFromJSON(gjson)
+	if err != nil {
+		t.Fatalf("cannot decode fixture: %v", err)
+	}
+	idx, err := FromFeatures(*fc, 0)
+	if err != nil {
+		t.Fatalf("FromFeatures error: %v", err)
+	}
+
+	for i := range fc.Features {
+		bbox, err := measurement.BBox(&fc.Features[i])
+		if err != nil {
+			t.Fatalf("BBox error: %v", err)
+		}
+		found := false
+		for _, j := range idx.Search(bbox[0], bbox[1], bbox[2], bbox[3]) {
+			found = found || j == i
+		}
+		assert.Equal(t, found, true)
+	}
+}
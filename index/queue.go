@@ -0,0 +1,93 @@
+package index
+
+import (
+	"math"
+
+	"github.com/tomchavakis/turf-go/conversions"
+)
+
+type queueItem struct {
+	index int
+	// leaf is true for items and false for nodes of the tree.
+	leaf bool
+	dist float64
+}
+
+// queue is a priority queue of items and nodes ordered by distance, it implements heap.Interface.
+type queue []queueItem
+
+func (q queue) Len() int {
+	return len(q)
+}
+
+func (q queue) Less(i, j int) bool {
+	return q[i].dist < q[j].dist
+}
+
+func (q queue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *queue) Push(x interface{}) {
+	*q = append(*q, x.(queueItem))
+}
+
+func (q *queue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
+
+// boxDist returns a lower bound of the great circle distance from a location to the points inside a bounding box,
+// as the haversine of the angular distance.
+func boxDist(lng float64, lat float64, cosLat float64, sinLat float64, minLng float64, minLat float64, maxLng float64, maxLat float64) float64 {
+	if minLng == maxLng && minLat == maxLat {
+		return greatCircleDist(lng, minLng, minLat, cosLat, sinLat)
+	}
+
+	// query point is between minimum and maximum longitudes
+	if lng >= minLng && lng <= maxLng {
+		if lat <= minLat {
+			return haverSin(conversions.DegreesToRadians(minLat - lat))
+		}
+		if lat >= maxLat {
+			return haverSin(conversions.DegreesToRadians(lat - maxLat))
+		}
+		return 0
+	}
+
+	// query point is west or east of the bounding box;
+	// calculate the extremum for great circle distance from query point to the closest longitude
+	closestLng := maxLng
+	if math.Mod(minLng-lng+360, 360) <= math.Mod(lng-maxLng+360, 360) {
+		closestLng = minLng
+	}
+	cosLngDelta := math.Cos(conversions.DegreesToRadians(closestLng - lng))
+	extremumLat := conversions.RadiansToDegrees(math.Atan(sinLat / (cosLat * cosLngDelta)))
+
+	// calculate distances to lower and higher bbox corners and extremum (if it's within this range);
+	// one of the three distances will be the lower bound of great circle distance to bbox
+	d := math.Max(greatCircleDistPart(minLat, cosLat, sinLat, cosLngDelta), greatCircleDistPart(maxLat, cosLat, sinLat, cosLngDelta))
+	if extremumLat > minLat && extremumLat < maxLat {
+		d = math.Max(d, greatCircleDistPart(extremumLat, cosLat, sinLat, cosLngDelta))
+	}
+	return (1 - d) / 2
+}
+
+func greatCircleDist(lng float64, lng2 float64, lat2 float64, cosLat float64, sinLat float64) float64 {
+	cosLngDelta := math.Cos(conversions.DegreesToRadians(lng2 - lng))
+	return (1 - greatCircleDistPart(lat2, cosLat, sinLat, cosLngDelta)) / 2
+}
+
+func greatCircleDistPart(lat float64, cosLat float64, sinLat float64, cosLngDelta float64) float64 {
+	rad := conversions.DegreesToRadians(lat)
+	d := sinLat*math.Sin(rad) + cosLat*math.Cos(rad)*cosLngDelta
+	return math.Min(d, 1)
+}
+
+func haverSin(theta float64) float64 {
+	s := math.Sin(theta / 2)
+	return s * s
+}
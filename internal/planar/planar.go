@@ -0,0 +1,53 @@
+// Package planar holds the segment operations shared by the booleans and misc packages. They work in the plane of
+// the longitudes and latitudes, within a tolerance of Epsilon degrees.
+package planar
+
+import (
+	"math"
+
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// Epsilon is the distance in degrees under which two points are considered the same and a point is considered to
+// lie on a segment.
+const Epsilon = 1e-9
+
+// SamePoint returns true if the points are within Epsilon of each other in longitude and latitude.
+func SamePoint(p1 geometry.Point, p2 geometry.Point) bool {
+	return math.Abs(p1.Lat-p2.Lat) <= Epsilon && math.Abs(p1.Lng-p2.Lng) <= Epsilon
+}
+
+// Cross returns twice the signed area of the triangle o, a, b, positive if it turns left from a to b.
+func Cross(o geometry.Point, a geometry.Point, b geometry.Point) float64 {
+	return (a.Lng-o.Lng)*(b.Lat-o.Lat) - (a.Lat-o.Lat)*(b.Lng-o.Lng)
+}
+
+// OnSegment returns true if the point lies on the segment ab including its ends.
+func OnSegment(pt geometry.Point, a geometry.Point, b geometry.Point) bool {
+	length := math.Hypot(b.Lng-a.Lng, b.Lat-a.Lat)
+	if length == 0 {
+		return SamePoint(pt, a)
+	}
+	if math.Abs(Cross(a, b, pt)) > Epsilon*length {
+		return false
+	}
+	return pt.Lng >= math.Min(a.Lng, b.Lng)-Epsilon && pt.Lng <= math.Max(a.Lng, b.Lng)+Epsilon &&
+		pt.Lat >= math.Min(a.Lat, b.Lat)-Epsilon && pt.Lat <= math.Max(a.Lat, b.Lat)+Epsilon
+}
+
+// SegmentIntersection returns the point where the segments ab and cd intersect and its position along ab, as a
+// fraction of its length. It returns false when the segments don't intersect or are parallel.
+func SegmentIntersection(a geometry.Point, b geometry.Point, c geometry.Point, d geometry.Point) (geometry.Point, float64, bool) {
+	rx, ry := b.Lng-a.Lng, b.Lat-a.Lat
+	sx, sy := d.Lng-c.Lng, d.Lat-c.Lat
+	denom := rx*sy - ry*sx
+	if denom == 0 {
+		return geometry.Point{}, 0, false
+	}
+	t := ((c.Lng-a.Lng)*sy - (c.Lat-a.Lat)*sx) / denom
+	u := ((c.Lng-a.Lng)*ry - (c.Lat-a.Lat)*rx) / denom
+	if t < 0 || t > 1 || u < 0 || u > 1 {
+		return geometry.Point{}, 0, false
+	}
+	return geometry.Point{Lng: a.Lng + t*rx, Lat: a.Lat + t*ry}, t, true
+}
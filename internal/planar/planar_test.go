@@ -0,0 +1,62 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+func TestOnSegment(t *testing.T) {
+	a, b := geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 2, Lat: 2}
+	tests := map[string]struct {
+		point geometry.Point
+		want  bool
+	}{
+		"middle":         {point: geometry.Point{Lng: 1, Lat: 1}, want: true},
+		"end":            {point: geometry.Point{Lng: 2, Lat: 2}, want: true},
+		"within":         {point: geometry.Point{Lng: 1, Lat: 1 + Epsilon/2}, want: true},
+		"beside":         {point: geometry.Point{Lng: 1, Lat: 1.1}, want: false},
+		"beyond the end": {point: geometry.Point{Lng: 3, Lat: 3}, want: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, OnSegment(tt.point, a, b), tt.want)
+		})
+	}
+	assert.Equal(t, OnSegment(a, a, a), true)
+}
+
+func TestSegmentIntersection(t *testing.T) {
+	tests := map[string]struct {
+		c, d     geometry.Point
+		want     geometry.Point
+		along    float64
+		crossing bool
+	}{
+		"crossing": {
+			c: geometry.Point{Lng: 1, Lat: -1}, d: geometry.Point{Lng: 1, Lat: 1},
+			want: geometry.Point{Lng: 1, Lat: 0}, along: 0.25, crossing: true,
+		},
+		"touching": {
+			c: geometry.Point{Lng: 4, Lat: 0}, d: geometry.Point{Lng: 4, Lat: 1},
+			want: geometry.Point{Lng: 4, Lat: 0}, along: 1, crossing: true,
+		},
+		"apart": {
+			c: geometry.Point{Lng: 5, Lat: -1}, d: geometry.Point{Lng: 5, Lat: 1},
+		},
+		"parallel": {
+			c: geometry.Point{Lng: 0, Lat: 1}, d: geometry.Point{Lng: 4, Lat: 1},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, along, ok := SegmentIntersection(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 4, Lat: 0}, tt.c, tt.d)
+			assert.Equal(t, ok, tt.crossing)
+			if ok {
+				assert.Equal(t, SamePoint(p, tt.want), true)
+				assert.Equal(t, along, tt.along)
+			}
+		})
+	}
+}
@@ -1,11 +1,44 @@
 package turf
 
 import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/tomchavakis/turf-go/geojson"
+	"github.com/tomchavakis/turf-go/geojson/feature"
 	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/index"
 )
 
-// PointInPolygon takes a Point and a Polygon and determines if the point resides inside the polygon
+// PointInPolygonOptions configures how PointInPolygonWithOptions treats points on the boundary of the polygon.
+type PointInPolygonOptions struct {
+	// IgnoreBoundary makes points on the boundary of the polygon, including the boundary of its holes, count as outside.
+	IgnoreBoundary bool
+	// Epsilon is the distance in degrees within which a point is considered to be on an edge of the polygon.
+	Epsilon float64
+	// Antimeridian makes the edges spanning more than 180 degrees of longitude cross the antimeridian instead of
+	// going the long way around the world, for rings straddling the dateline written with longitudes in [-180, 180].
+	// Edges between two points on the antimeridian, as the ones of a polygon covering all longitudes, are kept.
+	Antimeridian bool
+}
+
+// location of a point relative to a polygon
+const (
+	outsidePolygon = iota
+	onBoundary
+	insidePolygon
+)
+
+// PointInPolygon takes a Point and a Polygon and determines if the point resides inside the polygon.
+// Points on the boundary of the polygon are considered inside.
 func PointInPolygon(point geometry.Point, polygon geometry.Polygon) (bool, error) {
+	return PointInPolygonWithOptions(point, polygon, PointInPolygonOptions{})
+}
+
+// PointInPolygonWithOptions takes a Point and a Polygon and determines if the point resides inside the polygon.
+// Polygons crossing the antimeridian are supported with the Antimeridian option.
+func PointInPolygonWithOptions(point geometry.Point, polygon geometry.Polygon, options PointInPolygonOptions) (bool, error) {
 
 	pArr := []geometry.Polygon{}
 	pArr = append(pArr, polygon)
@@ -15,34 +48,30 @@ func PointInPolygon(point geometry.Point, polygon geometry.Polygon) (bool, error
 		return false, err
 	}
 
-	return PointInMultiPolygon(point, *mp), nil
+	return PointInMultiPolygonWithOptions(point, *mp, options), nil
 }
 
-// PointInMultiPolygon takes a Point and a MultiPolygon and determines if the point resides inside the polygon
+// PointInMultiPolygon takes a Point and a MultiPolygon and determines if the point resides inside the polygon.
+// Points on the boundary of the polygon are considered inside.
 func PointInMultiPolygon(p geometry.Point, mp geometry.MultiPolygon) bool {
+	return PointInMultiPolygonWithOptions(p, mp, PointInPolygonOptions{})
+}
 
-	insidePoly := false
-	polys := mp.Coordinates
-
-	for i := 0; i < len(polys) && !insidePoly; i++ {
-		//check if it is in the outer ring first
-		if inRing(p, polys[i].Coordinates[0].Coordinates) {
-			inHole := false
-			temp := 1
-			// check for the point in any of the holes
-			for temp < len(polys[i].Coordinates) && !inHole {
-				if inRing(p, polys[i].Coordinates[temp].Coordinates) {
-					inHole = true
-				}
-				temp++
-			}
-			if !inHole {
-				insidePoly = true
+// PointInMultiPolygonWithOptions takes a Point and a MultiPolygon and determines if the point resides inside the polygon.
+// Polygons crossing the antimeridian are supported with the Antimeridian option.
+func PointInMultiPolygonWithOptions(p geometry.Point, mp geometry.MultiPolygon, options PointInPolygonOptions) bool {
+	for _, poly := range mp.Coordinates {
+		switch locateInPolygon(p, poly.Coordinates, options) {
+		case insidePolygon:
+			return true
+		case onBoundary:
+			// the point may still be inside another polygon of the MultiPolygon
+			if !options.IgnoreBoundary {
+				return true
 			}
 		}
 	}
-
-	return insidePoly
+	return false
 }
 
 // optionally
@@ -53,7 +82,126 @@ func PointInMultiPolygon(p geometry.Point, mp geometry.MultiPolygon) bool {
 // 		bbox.North >= pt.Lat
 // }
 
-func inRing(pt geometry.Point, ring []geometry.Point) bool {
+func locateInPolygon(p geometry.Point, rings []geometry.LineString, options PointInPolygonOptions) int {
+	if len(rings) == 0 {
+		return outsidePolygon
+	}
+
+	lngs := []float64{p.Lng}
+	if options.Antimeridian {
+		lngs = longitudes(p)
+	}
+	unwrapped := make([][]geometry.Point, len(rings))
+	for i, r := range rings {
+		unwrapped[i] = r.Coordinates
+		if options.Antimeridian {
+			unwrapped[i] = unwrapRing(r.Coordinates)
+		}
+		if onRing(p, lngs, unwrapped[i], options.Epsilon) {
+			return onBoundary
+		}
+	}
+
+	//check if it is in the outer ring first
+	if !inRing(p, lngs, unwrapped[0]) {
+		return outsidePolygon
+	}
+	// check for the point in any of the holes
+	for _, hole := range unwrapped[1:] {
+		if inRing(p, lngs, hole) {
+			return outsidePolygon
+		}
+	}
+	return insidePolygon
+}
+
+// unwrapRing shifts the longitudes of a ring crossing the antimeridian by multiples of 360 degrees,
+// so that none of its edges spans more than 180 degrees of longitude. The edges between two points on the
+// antimeridian are left as they are.
+func unwrapRing(ring []geometry.Point) []geometry.Point {
+	crosses := false
+	for i := 1; i < len(ring); i++ {
+		if crossesAntimeridian(ring[i-1], ring[i]) {
+			crosses = true
+			break
+		}
+	}
+	if !crosses {
+		return ring
+	}
+
+	unwrapped := make([]geometry.Point, len(ring))
+	unwrapped[0] = ring[0]
+	for i := 1; i < len(ring); i++ {
+		delta := ring[i].Lng - ring[i-1].Lng
+		if crossesAntimeridian(ring[i-1], ring[i]) {
+			if delta > 180 {
+				delta -= 360
+			} else {
+				delta += 360
+			}
+		}
+		unwrapped[i] = ring[i]
+		unwrapped[i].Lng = unwrapped[i-1].Lng + delta
+	}
+	return unwrapped
+}
+
+// crossesAntimeridian returns true if the edge spans more than 180 degrees of longitude and doesn't lie on the
+// antimeridian.
+func crossesAntimeridian(a geometry.Point, b geometry.Point) bool {
+	if math.Abs(a.Lng) == 180 && math.Abs(b.Lng) == 180 {
+		return false
+	}
+	return math.Abs(b.Lng-a.Lng) > 180
+}
+
+// longitudes returns the longitude of the point together with its equivalents on the neighbouring copies of
+// the world, so that it can be compared with rings whose longitudes are outside the [-180, 180] range.
+func longitudes(p geometry.Point) []float64 {
+	return []float64{p.Lng, p.Lng - 360, p.Lng + 360}
+}
+
+func onRing(p geometry.Point, lngs []float64, ring []geometry.Point, epsilon float64) bool {
+	for _, lng := range lngs {
+		pt := geometry.Point{Lat: p.Lat, Lng: lng}
+		for i := 0; i < len(ring)-1; i++ {
+			if onSegment(pt, ring[i], ring[i+1], epsilon) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// onSegment returns true if the distance of the point from the segment ab isn't greater than epsilon.
+func onSegment(p geometry.Point, a geometry.Point, b geometry.Point, epsilon float64) bool {
+	dx, dy := b.Lng-a.Lng, b.Lat-a.Lat
+	lengthSq := dx*dx + dy*dy
+	if lengthSq == 0 {
+		return math.Hypot(p.Lng-a.Lng, p.Lat-a.Lat) <= epsilon
+	}
+	if epsilon == 0 {
+		cross := (p.Lng-a.Lng)*dy - (p.Lat-a.Lat)*dx
+		return cross == 0 &&
+			p.Lng >= math.Min(a.Lng, b.Lng) && p.Lng <= math.Max(a.Lng, b.Lng) &&
+			p.Lat >= math.Min(a.Lat, b.Lat) && p.Lat <= math.Max(a.Lat, b.Lat)
+	}
+	// distance from the closest point of the segment
+	t := math.Max(0, math.Min(1, ((p.Lng-a.Lng)*dx+(p.Lat-a.Lat)*dy)/lengthSq))
+	return math.Hypot(p.Lng-(a.Lng+t*dx), p.Lat-(a.Lat+t*dy)) <= epsilon
+}
+
+func inRing(p geometry.Point, lngs []float64, ring []geometry.Point) bool {
+	for _, lng := range lngs {
+		if inRingAt(geometry.Point{Lat: p.Lat, Lng: lng}, ring) {
+			return true
+		}
+	}
+	return false
+}
+
+func inRingAt(pt geometry.Point, ring []geometry.Point) bool {
 
 	isInside := false
 	j := 0
@@ -74,3 +222,180 @@ func inRing(pt geometry.Point, ring []geometry.Point) bool {
 	}
 	return isInside
 }
+
+// PointInGeometryCollection takes a Point and a GeometryCollection and determines if the point resides inside any of
+// the polygons of the collection, including the ones of nested collections.
+func PointInGeometryCollection(p geometry.Point, gc geometry.Collection) (bool, error) {
+	objects, err := gc.Objects()
+	if err != nil {
+		return false, err
+	}
+
+	for _, o := range objects {
+		switch gtp := o.(type) {
+		case *geometry.Polygon:
+			if PointInMultiPolygon(p, geometry.MultiPolygon{Coordinates: []geometry.Polygon{*gtp}}) {
+				return true, nil
+			}
+		case *geometry.MultiPolygon:
+			if PointInMultiPolygon(p, *gtp) {
+				return true, nil
+			}
+		case *geometry.Collection:
+			in, err := PointInGeometryCollection(p, *gtp)
+			if err != nil {
+				return false, err
+			}
+			if in {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// PointsWithinPolygon takes a collection of Point and MultiPoint features and a collection of Polygon and MultiPolygon
+// features and returns the points which fall within any of the polygons.
+// MultiPoint features are returned with only their points which fall within the polygons.
+func PointsWithinPolygon(points feature.Collection, polygons feature.Collection) (*feature.Collection, error) {
+	idx, err := index.FromFeatures(polygons, index.DefaultNodeSize)
+	if err != nil {
+		return nil, err
+	}
+	return PointsWithinPolygonWithIndex(points, polygons, idx)
+}
+
+// PointsWithinPolygonWithIndex is PointsWithinPolygon using an index of the polygons created with index.FromFeatures,
+// so that the index can be reused for many collections of points. It returns an error if the index doesn't have one
+// item per polygon.
+func PointsWithinPolygonWithIndex(points feature.Collection, polygons feature.Collection, idx *index.Index) (*feature.Collection, error) {
+	mps, err := multiPolygons(polygons)
+	if err != nil {
+		return nil, err
+	}
+	if idx.NumItems() != len(mps) {
+		return nil, errors.New("the index doesn't match the polygons")
+	}
+
+	within := []feature.Feature{}
+	for _, f := range points.Features {
+		switch f.Geometry.GeoJSONType {
+		case geojson.Point:
+			p, err := f.ToPoint()
+			if err != nil {
+				return nil, err
+			}
+			if inAny(*p, mps, idx) {
+				within = append(within, f)
+			}
+		case geojson.MultiPoint:
+			mp, err := f.ToMultiPoint()
+			if err != nil {
+				return nil, err
+			}
+			var inside []geometry.Point
+			for _, p := range mp.Coordinates {
+				if inAny(p, mps, idx) {
+					inside = append(inside, p)
+				}
+			}
+			if len(inside) == 0 {
+				continue
+			}
+			g, err := geometry.NewGeometry(geometry.MultiPoint{Coordinates: inside})
+			if err != nil {
+				return nil, err
+			}
+			f.Geometry = *g
+			within = append(within, f)
+		default:
+			return nil, errors.New("the points must be Point or MultiPoint features")
+		}
+	}
+	return feature.NewFeatureCollection(within)
+}
+
+// Tag takes a collection of Point features and a collection of Polygon and MultiPolygon features and copies
+// the field property of the first polygon each point falls within to the outField property of the point.
+// The points are copied, the input collection isn't modified.
+func Tag(points feature.Collection, polygons feature.Collection, field string, outField string) (*feature.Collection, error) {
+	idx, err := index.FromFeatures(polygons, index.DefaultNodeSize)
+	if err != nil {
+		return nil, err
+	}
+	return TagWithIndex(points, polygons, field, outField, idx)
+}
+
+// TagWithIndex is Tag using an index of the polygons created with index.FromFeatures,
+// so that the index can be reused for many collections of points. It returns an error if the index doesn't have one
+// item per polygon.
+func TagWithIndex(points feature.Collection, polygons feature.Collection, field string, outField string, idx *index.Index) (*feature.Collection, error) {
+	mps, err := multiPolygons(polygons)
+	if err != nil {
+		return nil, err
+	}
+	if idx.NumItems() != len(mps) {
+		return nil, errors.New("the index doesn't match the polygons")
+	}
+
+	tagged := make([]feature.Feature, 0, len(points.Features))
+	for _, f := range points.Features {
+		p, err := f.ToPoint()
+		if err != nil {
+			return nil, err
+		}
+		properties := make(map[string]interface{}, len(f.Properties)+1)
+		for k, v := range f.Properties {
+			properties[k] = v
+		}
+		for _, i := range candidates(*p, idx) {
+			if PointInMultiPolygon(*p, mps[i]) {
+				properties[outField] = polygons.Features[i].Properties[field]
+				break
+			}
+		}
+		f.Properties = properties
+		tagged = append(tagged, f)
+	}
+	return feature.NewFeatureCollection(tagged)
+}
+
+// multiPolygons converts every Polygon and MultiPolygon feature of the collection to a MultiPolygon.
+func multiPolygons(polygons feature.Collection) ([]geometry.MultiPolygon, error) {
+	mps := make([]geometry.MultiPolygon, 0, len(polygons.Features))
+	for _, f := range polygons.Features {
+		switch f.Geometry.GeoJSONType {
+		case geojson.Polygon:
+			poly, err := f.ToPolygon()
+			if err != nil {
+				return nil, err
+			}
+			mps = append(mps, geometry.MultiPolygon{Coordinates: []geometry.Polygon{*poly}})
+		case geojson.MultiPolygon:
+			mp, err := f.ToMultiPolygon()
+			if err != nil {
+				return nil, err
+			}
+			mps = append(mps, *mp)
+		default:
+			return nil, errors.New("the polygons must be Polygon or MultiPolygon features")
+		}
+	}
+	return mps, nil
+}
+
+func inAny(p geometry.Point, mps []geometry.MultiPolygon, idx *index.Index) bool {
+	for _, i := range candidates(p, idx) {
+		if PointInMultiPolygon(p, mps[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// candidates returns the indices of the polygons whose bounding boxes contain the point, in ascending order.
+func candidates(p geometry.Point, idx *index.Index) []int {
+	found := idx.Search(p.Lng, p.Lat, p.Lng, p.Lat)
+	sort.Ints(found)
+	return found
+}
@@ -8,11 +8,13 @@ import (
 	"github.com/tomchavakis/turf-go/geojson"
 	"github.com/tomchavakis/turf-go/geojson/feature"
 	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/index"
 	"github.com/tomchavakis/turf-go/utils"
 )
 
 const PolyWithHoleFixture = "test-data/poly-with-hole.json"
 const MultiPolyWithHoleFixture = "test-data/multipoly-with-hole.json"
+const GeometryCollectionFixture = "test-data/geometry-collection.json"
 
 func TestPointInPolygon(t *testing.T) {
 	type args struct {
@@ -335,3 +337,327 @@ func TestMultiPolyWithHole(t *testing.T) {
 		}
 	}
 }
+
+func TestPointInGeometryCollection(t *testing.T) {
+	fixture, err := utils.LoadJSONFixture(GeometryCollectionFixture)
+	if err != nil {
+		t.Errorf("LoadJSONFixture error: %v", err)
+	}
+
+	f, err := feature.FromJSON(fixture)
+	if err != nil {
+		t.Errorf("FromJSON error: %v", err)
+	}
+
+	gc, err := f.ToGeometryCollection()
+	if err != nil {
+		t.Errorf("ToGeometryCollection error: %v", err)
+	}
+
+	in, err := PointInGeometryCollection(geometry.Point{Lat: -20, Lng: 130}, *gc)
+	if err != nil {
+		t.Errorf("PointInGeometryCollection error: %v", err)
+	}
+	if !in {
+		t.Error("point should be inside the nested polygon")
+	}
+
+	in, err = PointInGeometryCollection(geometry.Point{Lat: 0, Lng: 100}, *gc)
+	if err != nil {
+		t.Errorf("PointInGeometryCollection error: %v", err)
+	}
+	if in {
+		t.Error("point should not be inside the collection")
+	}
+}
+
+func TestPointInPolygonWithOptions(t *testing.T) {
+	square := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 10, Lat: 0}, {Lng: 10, Lat: 10}, {Lng: 0, Lat: 10}, {Lng: 0, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 4, Lat: 4}, {Lng: 6, Lat: 4}, {Lng: 6, Lat: 6}, {Lng: 4, Lat: 6}, {Lng: 4, Lat: 4}}},
+	}}
+	// a maritime zone straddling the dateline
+	dateline := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 170, Lat: -10}, {Lng: -170, Lat: -10}, {Lng: -170, Lat: 10}, {Lng: 170, Lat: 10}, {Lng: 170, Lat: -10}}},
+	}}
+	world := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: -180, Lat: -90}, {Lng: 180, Lat: -90}, {Lng: 180, Lat: 90}, {Lng: -180, Lat: 90}, {Lng: -180, Lat: -90}}},
+	}}
+	// a polygon wider than 180 degrees which doesn't cross the antimeridian
+	wide := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: -100, Lat: 0}, {Lng: 100, Lat: 0}, {Lng: 100, Lat: 10}, {Lng: -100, Lat: 10}, {Lng: -100, Lat: 0}}},
+	}}
+	antimeridian := PointInPolygonOptions{Antimeridian: true}
+
+	tests := map[string]struct {
+		point   geometry.Point
+		polygon geometry.Polygon
+		options PointInPolygonOptions
+		want    bool
+	}{
+		"inside": {
+			point:   geometry.Point{Lng: 2, Lat: 2},
+			polygon: square,
+			want:    true,
+		},
+		"on edge": {
+			point:   geometry.Point{Lng: 10, Lat: 5},
+			polygon: square,
+			want:    true,
+		},
+		"on vertex": {
+			point:   geometry.Point{Lng: 0, Lat: 10},
+			polygon: square,
+			want:    true,
+		},
+		"on edge ignoring boundary": {
+			point:   geometry.Point{Lng: 10, Lat: 5},
+			polygon: square,
+			options: PointInPolygonOptions{IgnoreBoundary: true},
+			want:    false,
+		},
+		"on vertex ignoring boundary": {
+			point:   geometry.Point{Lng: 0, Lat: 0},
+			polygon: square,
+			options: PointInPolygonOptions{IgnoreBoundary: true},
+			want:    false,
+		},
+		"on hole edge": {
+			point:   geometry.Point{Lng: 5, Lat: 4},
+			polygon: square,
+			want:    true,
+		},
+		"on hole edge ignoring boundary": {
+			point:   geometry.Point{Lng: 5, Lat: 4},
+			polygon: square,
+			options: PointInPolygonOptions{IgnoreBoundary: true},
+			want:    false,
+		},
+		"in hole": {
+			point:   geometry.Point{Lng: 5, Lat: 5},
+			polygon: square,
+			want:    false,
+		},
+		"near edge within epsilon": {
+			point:   geometry.Point{Lng: 10.0000001, Lat: 5},
+			polygon: square,
+			options: PointInPolygonOptions{IgnoreBoundary: true, Epsilon: 1e-6},
+			want:    false,
+		},
+		"near edge outside": {
+			point:   geometry.Point{Lng: 10.0000001, Lat: 5},
+			polygon: square,
+			options: PointInPolygonOptions{Epsilon: 1e-6},
+			want:    true,
+		},
+		"across the dateline east": {
+			point:   geometry.Point{Lng: 175, Lat: 0},
+			polygon: dateline,
+			options: antimeridian,
+			want:    true,
+		},
+		"across the dateline west": {
+			point:   geometry.Point{Lng: -175, Lat: 0},
+			polygon: dateline,
+			options: antimeridian,
+			want:    true,
+		},
+		"on the dateline": {
+			point:   geometry.Point{Lng: 180, Lat: 5},
+			polygon: dateline,
+			options: antimeridian,
+			want:    true,
+		},
+		"outside the dateline polygon": {
+			point:   geometry.Point{Lng: 0, Lat: 0},
+			polygon: dateline,
+			options: antimeridian,
+			want:    false,
+		},
+		"dateline polygon as written": {
+			point:   geometry.Point{Lng: 0, Lat: 0},
+			polygon: dateline,
+			want:    true,
+		},
+		"in the world": {
+			point:   geometry.Point{Lng: 10, Lat: 10},
+			polygon: world,
+			want:    true,
+		},
+		"in the world with the antimeridian option": {
+			point:   geometry.Point{Lng: 10, Lat: 10},
+			polygon: world,
+			options: antimeridian,
+			want:    true,
+		},
+		"on the edge of the world": {
+			point:   geometry.Point{Lng: 180, Lat: 10},
+			polygon: world,
+			options: antimeridian,
+			want:    true,
+		},
+		"in a wide polygon": {
+			point:   geometry.Point{Lng: 0, Lat: 5},
+			polygon: wide,
+			want:    true,
+		},
+		"outside a wide polygon": {
+			point:   geometry.Point{Lng: 179, Lat: 5},
+			polygon: wide,
+			want:    false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := PointInPolygonWithOptions(tt.point, tt.polygon, tt.options)
+			if err != nil {
+				t.Errorf("PointInPolygonWithOptions error: %v", err)
+			}
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+const ServiceZonesFixture = "test-data/service-zones.json"
+const DeliveryStopsFixture = "test-data/delivery-stops.json"
+
+func loadCollection(t *testing.T, filename string) *feature.Collection {
+	gjson, err := utils.LoadJSONFixture(filename)
+	if err != nil {
+		t.Fatalf("cannot load fixture %v", err)
+	}
+	fc, err := feature.CollectionFromJSON(gjson)
+	if err != nil {
+		t.Fatalf("cannot decode fixture %v", err)
+	}
+	return fc
+}
+
+func TestPointsWithinPolygon(t *testing.T) {
+	zones := loadCollection(t, ServiceZonesFixture)
+	stops := loadCollection(t, DeliveryStopsFixture)
+
+	within, err := PointsWithinPolygon(*stops, *zones)
+	if err != nil {
+		t.Errorf("PointsWithinPolygon error: %v", err)
+	}
+	assert.Equal(t, len(within.Features), 3)
+	for i, want := range []float64{1, 2, 3} {
+		assert.Equal(t, within.Features[i].Properties["stop"], want)
+	}
+
+	mp := geometry.MultiPoint{Coordinates: []geometry.Point{{Lng: 23.75, Lat: 38.05}, {Lng: 23.85, Lat: 37.95}}}
+	g, err := geometry.NewGeometry(mp)
+	if err != nil {
+		t.Fatalf("NewGeometry error: %v", err)
+	}
+	f, _ := feature.New(*g, nil, nil, feature.ID{})
+	points, _ := feature.NewFeatureCollection([]feature.Feature{*f})
+	within, err = PointsWithinPolygon(*points, *zones)
+	if err != nil {
+		t.Errorf("PointsWithinPolygon error: %v", err)
+	}
+	assert.Equal(t, len(within.Features), 1)
+	got, err := within.Features[0].ToMultiPoint()
+	if err != nil {
+		t.Errorf("ToMultiPoint error: %v", err)
+	}
+	assert.Equal(t, len(got.Coordinates), 1)
+	assert.Equal(t, got.Coordinates[0], geometry.Point{Lng: 23.75, Lat: 38.05})
+
+	_, err = PointsWithinPolygon(*zones, *zones)
+	if err == nil {
+		t.Errorf("PointsWithinPolygon expected an error for polygon points")
+	}
+}
+
+func TestTag(t *testing.T) {
+	zones := loadCollection(t, ServiceZonesFixture)
+	stops := loadCollection(t, DeliveryStopsFixture)
+
+	tagged, err := Tag(*stops, *zones, "zone", "serviceZone")
+	if err != nil {
+		t.Errorf("Tag error: %v", err)
+	}
+	assert.Equal(t, len(tagged.Features), 4)
+	assert.Equal(t, tagged.Features[0].Properties["serviceZone"], "north")
+	assert.Equal(t, tagged.Features[1].Properties["serviceZone"], "south")
+	assert.Equal(t, tagged.Features[2].Properties["serviceZone"], "south")
+	_, ok := tagged.Features[3].Properties["serviceZone"]
+	assert.Equal(t, ok, false)
+	assert.Equal(t, tagged.Features[0].Properties["stop"], float64(1))
+
+	// the input points aren't modified
+	_, ok = stops.Features[0].Properties["serviceZone"]
+	assert.Equal(t, ok, false)
+
+	_, err = Tag(*stops, *stops, "zone", "serviceZone")
+	if err == nil {
+		t.Errorf("Tag expected an error for point polygons")
+	}
+}
+
+func TestTagWithIndex(t *testing.T) {
+	zones := loadCollection(t, ServiceZonesFixture)
+	// a zone straddling the dateline split along it, its bbox spans all longitudes in the index
+	dateline := geometry.MultiPolygon{Coordinates: []geometry.Polygon{
+		{Coordinates: []geometry.LineString{
+			{Coordinates: []geometry.Point{{Lng: 170, Lat: -10}, {Lng: 180, Lat: -10}, {Lng: 180, Lat: 10}, {Lng: 170, Lat: 10}, {Lng: 170, Lat: -10}}},
+		}},
+		{Coordinates: []geometry.LineString{
+			{Coordinates: []geometry.Point{{Lng: -180, Lat: -10}, {Lng: -170, Lat: -10}, {Lng: -170, Lat: 10}, {Lng: -180, Lat: 10}, {Lng: -180, Lat: -10}}},
+		}},
+	}}
+	g, err := geometry.NewGeometry(dateline)
+	if err != nil {
+		t.Fatalf("NewGeometry error: %v", err)
+	}
+	f, _ := feature.New(*g, nil, map[string]interface{}{"zone": "pacific"}, feature.ID{})
+	zones.Features = append(zones.Features, *f)
+
+	idx, err := index.FromFeatures(*zones, 0)
+	if err != nil {
+		t.Fatalf("FromFeatures error: %v", err)
+	}
+
+	stops := []geometry.Point{{Lng: 23.75, Lat: 38.05}, {Lng: 175, Lat: 0}, {Lng: -175, Lat: 0}, {Lng: 0, Lat: 0}}
+	var features []feature.Feature
+	for _, s := range stops {
+		g, err := geometry.NewGeometry(s)
+		if err != nil {
+			t.Fatalf("NewGeometry error: %v", err)
+		}
+		f, _ := feature.New(*g, nil, nil, feature.ID{})
+		features = append(features, *f)
+	}
+	points, _ := feature.NewFeatureCollection(features)
+
+	tagged, err := TagWithIndex(*points, *zones, "zone", "serviceZone", idx)
+	if err != nil {
+		t.Errorf("TagWithIndex error: %v", err)
+	}
+	assert.Equal(t, tagged.Features[0].Properties["serviceZone"], "north")
+	assert.Equal(t, tagged.Features[1].Properties["serviceZone"], "pacific")
+	assert.Equal(t, tagged.Features[2].Properties["serviceZone"], "pacific")
+	assert.Equal(t, tagged.Features[3].Properties["serviceZone"], nil)
+
+	within, err := PointsWithinPolygonWithIndex(*points, *zones, idx)
+	if err != nil {
+		t.Errorf("PointsWithinPolygonWithIndex error: %v", err)
+	}
+	assert.Equal(t, len(within.Features), 3)
+
+	// an index of other polygons
+	stale, err := index.FromFeatures(feature.Collection{Features: zones.Features[:1]}, 0)
+	if err != nil {
+		t.Fatalf("FromFeatures error: %v", err)
+	}
+	_, err = TagWithIndex(*points, *zones, "zone", "serviceZone", stale)
+	if err == nil {
+		t.Errorf("TagWithIndex expected an error for an index of other polygons")
+	}
+	_, err = PointsWithinPolygonWithIndex(*points, *zones, stale)
+	if err == nil {
+		t.Errorf("PointsWithinPolygonWithIndex expected an error for an index of other polygons")
+	}
+}
@@ -0,0 +1,267 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	meta "github.com/tomchavakis/turf-go/meta/coordAll"
+)
+
+// Centroid takes a geometry and returns the mean of all its vertices.
+// The closing vertex of the rings of polygons is not counted twice.
+// t can be any typed geometry.Object, a *geometry.Geometry, a *feature.Feature or a *feature.Collection.
+func Centroid(t interface{}) (*geometry.Point, error) {
+	excludeWrapCoord := true
+	coords, err := meta.CoordAll(t, &excludeWrapCoord)
+	if err != nil {
+		return nil, err
+	}
+	if len(coords) == 0 {
+		return nil, errors.New("cannot calculate the centroid of an empty geometry")
+	}
+
+	lng := 0.0
+	lat := 0.0
+	for _, c := range coords {
+		lng += c.Lng
+		lat += c.Lat
+	}
+	return &geometry.Point{Lng: lng / float64(len(coords)), Lat: lat / float64(len(coords))}, nil
+}
+
+// CenterOfMass takes a geometry and returns its center of mass, the centroid of the area of its polygons
+// with the areas of their holes taken out.
+// If the geometry has no area the Centroid of its vertices is returned.
+// t can be any typed geometry.Object, a *geometry.Geometry, a *feature.Feature or a *feature.Collection.
+func CenterOfMass(t interface{}) (*geometry.Point, error) {
+	centroid, err := Centroid(t)
+	if err != nil {
+		return nil, err
+	}
+	polygons, err := polygonsOf(t)
+	if err != nil {
+		return nil, err
+	}
+
+	// the vertices are translated to the centroid to reduce the loss of precision
+	totalArea := 0.0
+	sx := 0.0
+	sy := 0.0
+	for _, poly := range polygons {
+		for i, r := range poly.Coordinates {
+			a, cx, cy := planarRingCentroid(r.Coordinates, *centroid)
+			// the outer ring adds to the mass and the holes take out of it, whatever the orientation of the rings
+			sign := 1.0
+			if (a < 0) != (i > 0) {
+				sign = -1.0
+			}
+			totalArea += sign * a
+			sx += sign * a * cx
+			sy += sign * a * cy
+		}
+	}
+	if totalArea == 0 {
+		return centroid, nil
+	}
+
+	return &geometry.Point{Lng: centroid.Lng + sx/totalArea, Lat: centroid.Lat + sy/totalArea}, nil
+}
+
+// PointOnFeature takes a geometry and returns a point guaranteed to be on its surface.
+// The centroid is returned if it lies in one of the polygons of the geometry, otherwise a point in the interior of
+// the largest polygon. A geometry without polygons returns its vertex which is closest to the centroid.
+// t can be any typed geometry.Object, a *geometry.Geometry, a *feature.Feature or a *feature.Collection.
+func PointOnFeature(t interface{}) (*geometry.Point, error) {
+	centroid, err := Centroid(t)
+	if err != nil {
+		return nil, err
+	}
+	polygons, err := polygonsOf(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(polygons) > 0 {
+		largest := 0
+		largestArea := 0.0
+		for i, poly := range polygons {
+			if inPolygonRings(*centroid, poly.Coordinates) {
+				return centroid, nil
+			}
+			a := polygonArea(poly.Coordinates, ringArea)
+			if a > largestArea {
+				largest = i
+				largestArea = a
+			}
+		}
+		if p := interiorPoint(polygons[largest].Coordinates); p != nil {
+			return p, nil
+		}
+	}
+
+	excludeWrapCoord := true
+	coords, err := meta.CoordAll(t, &excludeWrapCoord)
+	if err != nil {
+		return nil, err
+	}
+	nearest := coords[0]
+	minDist := math.Inf(1)
+	for _, c := range coords {
+		d, err := PointDistance(*centroid, c, "")
+		if err != nil {
+			return nil, err
+		}
+		if d < minDist {
+			nearest = c
+			minDist = d
+		}
+	}
+	return &geometry.Point{Lng: nearest.Lng, Lat: nearest.Lat}, nil
+}
+
+// polygonsOf returns the polygons of the geometry, skipping its points and lines.
+func polygonsOf(t interface{}) ([]geometry.Polygon, error) {
+	switch gtp := geometry.Pointer(t).(type) {
+	case *feature.Feature:
+		return polygonsOf(&gtp.Geometry)
+	case *feature.Collection:
+		var polygons []geometry.Polygon
+		for i := range gtp.Features {
+			p, err := polygonsOf(&gtp.Features[i].Geometry)
+			if err != nil {
+				return nil, err
+			}
+			polygons = append(polygons, p...)
+		}
+		return polygons, nil
+	case *geometry.Geometry:
+		o, err := gtp.ToObject()
+		if err != nil {
+			return nil, err
+		}
+		return polygonsOf(o)
+	case *geometry.Polygon:
+		return []geometry.Polygon{*gtp}, nil
+	case *geometry.MultiPolygon:
+		return gtp.Coordinates, nil
+	case *geometry.Collection:
+		objects, err := gtp.Objects()
+		if err != nil {
+			return nil, err
+		}
+		var polygons []geometry.Polygon
+		for _, o := range objects {
+			p, err := polygonsOf(o)
+			if err != nil {
+				return nil, err
+			}
+			polygons = append(polygons, p...)
+		}
+		return polygons, nil
+	}
+	return nil, nil
+}
+
+// planarRingCentroid returns the signed planar area of the ring and the coordinates of its centroid, both relative
+// to the origin. The area is positive if the ring is oriented counterclockwise.
+// https://en.wikipedia.org/wiki/Centroid#Of_a_polygon
+func planarRingCentroid(ring []geometry.Point, origin geometry.Point) (float64, float64, float64) {
+	a := 0.0
+	cx := 0.0
+	cy := 0.0
+	for i := 0; i < len(ring); i++ {
+		p1 := ring[i]
+		p2 := ring[(i+1)%len(ring)]
+		x1, y1 := p1.Lng-origin.Lng, p1.Lat-origin.Lat
+		x2, y2 := p2.Lng-origin.Lng, p2.Lat-origin.Lat
+		cross := x1*y2 - x2*y1
+		a += cross
+		cx += (x1 + x2) * cross
+		cy += (y1 + y2) * cross
+	}
+	if a == 0 {
+		return 0, 0, 0
+	}
+	return a / 2, cx / (3 * a), cy / (3 * a)
+}
+
+// inPolygonRings determines if the point is inside the outer ring and outside the holes, using ray casting.
+func inPolygonRings(p geometry.Point, rings []geometry.LineString) bool {
+	if len(rings) == 0 || !inPlanarRing(p, rings[0].Coordinates) {
+		return false
+	}
+	for _, hole := range rings[1:] {
+		if inPlanarRing(p, hole.Coordinates) {
+			return false
+		}
+	}
+	return true
+}
+
+func inPlanarRing(p geometry.Point, ring []geometry.Point) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a := ring[i]
+		b := ring[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// interiorPoint returns a point in the interior of the polygon. It is the middle of the widest interval of a
+// horizontal line through the polygon, at a latitude between two of its vertices so that the line doesn't touch any.
+func interiorPoint(rings []geometry.LineString) *geometry.Point {
+	var lats []float64
+	for _, r := range rings {
+		for _, c := range r.Coordinates {
+			lats = append(lats, c.Lat)
+		}
+	}
+	if len(lats) == 0 {
+		return nil
+	}
+	sort.Float64s(lats)
+	middle := (lats[0] + lats[len(lats)-1]) / 2
+
+	// the distinct vertex latitudes around the middle of the polygon
+	below := lats[0]
+	above := lats[len(lats)-1]
+	for _, lat := range lats {
+		if lat <= middle && lat > below {
+			below = lat
+		}
+		if lat > middle && lat < above {
+			above = lat
+		}
+	}
+	y := (below + above) / 2
+
+	var xs []float64
+	for _, r := range rings {
+		ring := r.Coordinates
+		for i := 1; i < len(ring); i++ {
+			a := ring[i-1]
+			b := ring[i]
+			if (a.Lat > y) != (b.Lat > y) {
+				xs = append(xs, a.Lng+(y-a.Lat)*(b.Lng-a.Lng)/(b.Lat-a.Lat))
+			}
+		}
+	}
+	sort.Float64s(xs)
+
+	var best *geometry.Point
+	width := 0.0
+	for i := 1; i < len(xs); i += 2 {
+		if xs[i]-xs[i-1] > width {
+			width = xs[i] - xs[i-1]
+			best = &geometry.Point{Lng: (xs[i] + xs[i-1]) / 2, Lat: y}
+		}
+	}
+	return best
+}
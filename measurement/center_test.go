@@ -0,0 +1,141 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// an L shaped polygon whose centroid and center of mass lie outside it
+var lShape = geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+	{Lng: 0, Lat: 0}, {Lng: 10, Lat: 0}, {Lng: 10, Lat: 2}, {Lng: 2, Lat: 2}, {Lng: 2, Lat: 10}, {Lng: 0, Lat: 10}, {Lng: 0, Lat: 0},
+}}}}
+
+func TestCentroid(t *testing.T) {
+	c, err := Centroid(&lShape)
+	if err != nil {
+		t.Errorf("Centroid error %v", err)
+	}
+	assert.Equal(t, *c, geometry.Point{Lng: 4, Lat: 4})
+
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 4}}}
+	c, err = Centroid(&ln)
+	if err != nil {
+		t.Errorf("Centroid error %v", err)
+	}
+	assert.Equal(t, *c, geometry.Point{Lng: 1, Lat: 2})
+
+	_, err = Centroid(&geometry.MultiPoint{})
+	if err == nil {
+		t.Errorf("Centroid expected an error for an empty geometry")
+	}
+}
+
+func TestCenterOfMass(t *testing.T) {
+	c, err := CenterOfMass(&lShape)
+	if err != nil {
+		t.Errorf("CenterOfMass error %v", err)
+	}
+	if math.Abs(c.Lng-29.0/9) > 1e-12 || math.Abs(c.Lat-29.0/9) > 1e-12 {
+		t.Errorf("CenterOfMass() = %v, want [29/9, 29/9]", c)
+	}
+
+	// a square with a hole in its north east quarter, with the rings in the same orientation
+	holed := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 4, Lat: 0}, {Lng: 4, Lat: 4}, {Lng: 0, Lat: 4}, {Lng: 0, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 2, Lat: 2}, {Lng: 4, Lat: 2}, {Lng: 4, Lat: 4}, {Lng: 2, Lat: 4}, {Lng: 2, Lat: 2}}},
+	}}
+	g, err := geometry.NewGeometry(&holed)
+	if err != nil {
+		t.Errorf("NewGeometry error %v", err)
+	}
+	f, err := feature.New(*g, nil, nil, feature.ID{})
+	if err != nil {
+		t.Errorf("New error %v", err)
+	}
+	c, err = CenterOfMass(f)
+	if err != nil {
+		t.Errorf("CenterOfMass error %v", err)
+	}
+	if math.Abs(c.Lng-5.0/3) > 1e-12 || math.Abs(c.Lat-5.0/3) > 1e-12 {
+		t.Errorf("CenterOfMass() = %v, want [5/3, 5/3]", c)
+	}
+
+	// without area the centroid is returned
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 4}}}
+	c, err = CenterOfMass(&ln)
+	if err != nil {
+		t.Errorf("CenterOfMass error %v", err)
+	}
+	assert.Equal(t, *c, geometry.Point{Lng: 1, Lat: 2})
+}
+
+func TestPointOnFeature(t *testing.T) {
+	p, err := PointOnFeature(&lShape)
+	if err != nil {
+		t.Errorf("PointOnFeature error %v", err)
+	}
+	assert.Equal(t, *p, geometry.Point{Lng: 1, Lat: 6})
+	assert.Equal(t, inPolygonRings(*p, lShape.Coordinates), true)
+
+	square := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: 2}, {Lng: 0, Lat: 2}, {Lng: 0, Lat: 0},
+	}}}}
+	p, err = PointOnFeature(&square)
+	if err != nil {
+		t.Errorf("PointOnFeature error %v", err)
+	}
+	assert.Equal(t, *p, geometry.Point{Lng: 1, Lat: 1})
+
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 4, Lat: 3}, {Lng: 10, Lat: 0}}}
+	p, err = PointOnFeature(&ln)
+	if err != nil {
+		t.Errorf("PointOnFeature error %v", err)
+	}
+	assert.Equal(t, *p, geometry.Point{Lng: 4, Lat: 3})
+}
+
+func TestValueTypes(t *testing.T) {
+	// the typed geometries are accepted by value as well as by pointer
+	c, err := Centroid(lShape)
+	if err != nil {
+		t.Fatalf("Centroid error %v", err)
+	}
+	assert.Equal(t, *c, geometry.Point{Lng: 4, Lat: 4})
+
+	want, err := CenterOfMass(&lShape)
+	if err != nil {
+		t.Fatalf("CenterOfMass error %v", err)
+	}
+	got, err := CenterOfMass(lShape)
+	if err != nil {
+		t.Fatalf("CenterOfMass error %v", err)
+	}
+	assert.Equal(t, *got, *want)
+
+	wantArea, err := Area(&lShape)
+	if err != nil {
+		t.Fatalf("Area error %v", err)
+	}
+	gotArea, err := Area(lShape)
+	if err != nil {
+		t.Fatalf("Area error %v", err)
+	}
+	if gotArea == 0 || gotArea != wantArea {
+		t.Errorf("Area() = %v, want %v", gotArea, wantArea)
+	}
+
+	wantLength, err := Length(&lShape, constants.UnitKilometers)
+	if err != nil {
+		t.Fatalf("Length error %v", err)
+	}
+	gotLength, err := Length(lShape, constants.UnitKilometers)
+	if err != nil {
+		t.Fatalf("Length error %v", err)
+	}
+	assert.Equal(t, gotLength, wantLength)
+}
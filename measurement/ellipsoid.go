@@ -0,0 +1,153 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/conversions"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// Ellipsoid is a reference ellipsoid of revolution used to measure on the earth without the error of the spherical model.
+// The inverse problem and the areas are solved with the algorithms of Karney, which converge for all points, and the
+// direct problem with the Vincenty formulae, both accurate to less than a millimetre.
+// https://doi.org/10.1007/s00190-012-0578-z
+// https://en.wikipedia.org/wiki/Vincenty%27s_formulae
+type Ellipsoid struct {
+	// SemiMajorAxis is the equatorial radius of the ellipsoid in meters.
+	SemiMajorAxis float64
+	// Flattening is the flattening of the ellipsoid, (a-b)/a.
+	Flattening float64
+}
+
+var (
+	// WGS84 is the World Geodetic System 1984 ellipsoid used by GPS and by GeoJSON coordinates.
+	WGS84 = Ellipsoid{SemiMajorAxis: constants.WGS84SemiMajorAxis, Flattening: constants.WGS84Flattening}
+	// GRS80 is the Geodetic Reference System 1980 ellipsoid used by ETRS89 and NAD83.
+	GRS80 = Ellipsoid{SemiMajorAxis: 6378137.0, Flattening: 1 / 298.257222101}
+)
+
+// vincentyEpsilon is the change of the iterated value below which the Vincenty formulae have converged, about 0.006mm.
+const vincentyEpsilon = 1e-12
+
+// Distance calculates the geodesic distance between two points on the ellipsoid.
+func (e Ellipsoid) Distance(p1 geometry.Point, p2 geometry.Point, units string) (float64, error) {
+	d, _, _, err := e.Inverse(p1, p2)
+	if err != nil {
+		return 0.0, err
+	}
+	return conversions.ConvertLength(d, constants.UnitMeters, units)
+}
+
+// Destination returns the destination point having travelled the given distance along the geodesic from the origin point
+// with the given bearing in degrees from True North.
+func (e Ellipsoid) Destination(origin geometry.Point, distance float64, bearing float64, units string) (*geometry.Point, error) {
+	meters, err := conversions.ConvertLength(distance, units, constants.UnitMeters)
+	if err != nil {
+		return nil, err
+	}
+	destination, _ := e.Direct(origin, meters, bearing)
+	return &destination, nil
+}
+
+// Length measures the length of a geometry along the geodesics of the ellipsoid.
+// t can be any typed geometry.Object, a *geometry.Geometry or a *feature.Feature.
+func (e Ellipsoid) Length(t interface{}, units string) (float64, error) {
+	return length(t, units, func(coords []geometry.Point, units string) (float64, error) {
+		travelled := 0.0
+		for i := 1; i < len(coords); i++ {
+			d, _, _, err := e.Inverse(coords[i-1], coords[i])
+			if err != nil {
+				return 0.0, err
+			}
+			travelled += d
+		}
+		return conversions.ConvertLength(travelled, constants.UnitMeters, units)
+	})
+}
+
+// Area takes a geometry type and returns its area on the ellipsoid in square meters, the edges of its rings being
+// geodesics.
+func (e Ellipsoid) Area(t interface{}) (float64, error) {
+	g := newGeodesic(e)
+	return area(t, g.ringArea)
+}
+
+// Inverse solves the inverse geodesic problem: it returns the distance in meters between two points along the geodesic
+// and the initial and final bearings of the geodesic in degrees from True North.
+// It converges for all points, including the nearly antipodal ones. An error is returned for latitudes out of
+// -90..90.
+func (e Ellipsoid) Inverse(p1 geometry.Point, p2 geometry.Point) (float64, float64, float64, error) {
+	if math.Abs(p1.Lat) > 90 || math.Abs(p2.Lat) > 90 {
+		return 0.0, 0.0, 0.0, errors.New("the latitude must be between -90 and 90")
+	}
+	line := newGeodesic(e).inverse(p1.Lat, p1.Lng, p2.Lat, p2.Lng)
+	return line.distance, line.azi1, line.azi2, nil
+}
+
+// Direct solves the direct geodesic problem: it returns the destination point having travelled the distance in meters
+// along the geodesic from the origin with the given bearing in degrees from True North, and the final bearing of the
+// geodesic at the destination. The longitude of the destination is normalised to -180..180.
+func (e Ellipsoid) Direct(origin geometry.Point, distance float64, bearing float64) (geometry.Point, float64) {
+	a := e.SemiMajorAxis
+	f := e.Flattening
+	b := a * (1 - f)
+
+	phi1 := conversions.DegreesToRadians(origin.Lat)
+	lambda1 := conversions.DegreesToRadians(origin.Lng)
+	sinAlpha1, cosAlpha1 := math.Sincos(conversions.DegreesToRadians(bearing))
+
+	U1 := math.Atan((1 - f) * math.Tan(phi1))
+	sinU1, cosU1 := math.Sincos(U1)
+	// angular distance on the sphere from the equator to the origin
+	sigma1 := math.Atan2(math.Tan(U1), cosAlpha1)
+	sinAlpha := cosU1 * sinAlpha1
+	cosSqAlpha := 1 - sinAlpha*sinAlpha
+
+	uSq := cosSqAlpha * (a*a - b*b) / (b * b)
+	A, B := vincentyCoefficients(uSq)
+
+	sigma := distance / (b * A)
+	var sinSigma, cosSigma, cos2SigmaM float64
+	for i := 0; i < 100; i++ {
+		cos2SigmaM = math.Cos(2*sigma1 + sigma)
+		sinSigma, cosSigma = math.Sincos(sigma)
+		deltaSigma := vincentyDeltaSigma(B, sinSigma, cosSigma, cos2SigmaM)
+		previous := sigma
+		sigma = distance/(b*A) + deltaSigma
+		if math.Abs(sigma-previous) <= vincentyEpsilon {
+			break
+		}
+	}
+	cos2SigmaM = math.Cos(2*sigma1 + sigma)
+	sinSigma, cosSigma = math.Sincos(sigma)
+
+	x := sinU1*sinSigma - cosU1*cosSigma*cosAlpha1
+	phi2 := math.Atan2(sinU1*cosSigma+cosU1*sinSigma*cosAlpha1, (1-f)*math.Hypot(sinAlpha, x))
+	lambda := math.Atan2(sinSigma*sinAlpha1, cosU1*cosSigma-sinU1*sinSigma*cosAlpha1)
+	C := f / 16 * cosSqAlpha * (4 + f*(4-3*cosSqAlpha))
+	L := lambda - (1-C)*f*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+	lambda2 := lambda1 + L
+
+	finalBearing := math.Atan2(sinAlpha, -x)
+
+	return geometry.Point{
+		// normalise to −180..+180°
+		Lng: math.Mod(conversions.RadiansToDegrees(lambda2)+540, 360) - 180,
+		Lat: conversions.RadiansToDegrees(phi2),
+	}, conversions.RadiansToDegrees(finalBearing)
+}
+
+// vincentyCoefficients returns the A and B coefficients of the Vincenty series for u².
+func vincentyCoefficients(uSq float64) (float64, float64) {
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	return A, B
+}
+
+// vincentyDeltaSigma returns the difference between the angular distance on the ellipsoid and on the auxiliary sphere.
+func vincentyDeltaSigma(B float64, sinSigma float64, cosSigma float64, cos2SigmaM float64) float64 {
+	return B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+}
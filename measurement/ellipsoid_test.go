@@ -0,0 +1,284 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// Flinders Peak and Buninyong on GRS80, the worked example of the Geoscience Australia geodetic calculations.
+var (
+	flindersPeak = geometry.Point{Lng: 144 + 25.0/60 + 29.52440/3600, Lat: -(37 + 57.0/60 + 3.72030/3600)}
+	buninyong    = geometry.Point{Lng: 143 + 55.0/60 + 35.38390/3600, Lat: -(37 + 39.0/60 + 10.15610/3600)}
+)
+
+func TestEllipsoidInverse(t *testing.T) {
+	d, initialBearing, finalBearing, err := GRS80.Inverse(flindersPeak, buninyong)
+	if err != nil {
+		t.Errorf("Inverse error %v", err)
+	}
+	if math.Abs(d-54972.271) > 0.001 {
+		t.Errorf("Inverse distance = %v, want 54972.271", d)
+	}
+	// 306°52′05.37″ and the reverse of 127°10′25.07″
+	if math.Abs(initialBearing-(306+52.0/60+5.37/3600-360)) > 0.01/3600 {
+		t.Errorf("Inverse initial bearing = %v", initialBearing)
+	}
+	if math.Abs(finalBearing-(127+10.0/60+25.07/3600-180)) > 0.01/3600 {
+		t.Errorf("Inverse final bearing = %v", finalBearing)
+	}
+
+	d, _, _, err = WGS84.Inverse(flindersPeak, flindersPeak)
+	if err != nil {
+		t.Errorf("Inverse error %v", err)
+	}
+	assert.Equal(t, d, 0.0)
+
+	// the example of Karney, "Algorithms for geodesics", table 3
+	d, initialBearing, finalBearing, err = WGS84.Inverse(geometry.Point{Lng: 0, Lat: -30}, geometry.Point{Lng: 179.8, Lat: 29.9})
+	if err != nil {
+		t.Errorf("Inverse error %v", err)
+	}
+	if math.Abs(d-19989832.827610) > 1e-6 {
+		t.Errorf("Inverse distance = %v, want 19989832.827610", d)
+	}
+	if math.Abs(initialBearing-161.890524736) > 1e-9 || math.Abs(finalBearing-18.090737246) > 1e-9 {
+		t.Errorf("Inverse bearings = %v, %v", initialBearing, finalBearing)
+	}
+
+	// antipodal points on the equator are joined through the poles
+	d, initialBearing, finalBearing, err = WGS84.Inverse(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 180, Lat: 0})
+	if err != nil {
+		t.Errorf("Inverse error %v", err)
+	}
+	if math.Abs(d-20003931.4586) > 1e-4 {
+		t.Errorf("Inverse distance = %v, want 20003931.4586", d)
+	}
+	assert.Equal(t, initialBearing, 0.0)
+	assert.Equal(t, finalBearing, 180.0)
+
+	// nearly antipodal points, where the Vincenty formulae don't converge
+	p1 := geometry.Point{Lng: 0, Lat: 0.5}
+	p2 := geometry.Point{Lng: 179.7, Lat: -0.5}
+	d, initialBearing, finalBearing, err = WGS84.Inverse(p1, p2)
+	if err != nil {
+		t.Errorf("Inverse error %v", err)
+	}
+	got, bearing := WGS84.Direct(p1, d, initialBearing)
+	if math.Abs(got.Lng-p2.Lng) > 1e-8 || math.Abs(got.Lat-p2.Lat) > 1e-8 || math.Abs(bearing-finalBearing) > 1e-8 {
+		t.Errorf("Direct(Inverse()) = %v %v, want %v %v", got, bearing, p2, finalBearing)
+	}
+	reverse, _, _, err := WGS84.Inverse(p2, p1)
+	if err != nil {
+		t.Errorf("Inverse error %v", err)
+	}
+	if math.Abs(reverse-d) > 1e-8 {
+		t.Errorf("Inverse reverse distance = %v, want %v", reverse, d)
+	}
+
+	_, _, _, err = WGS84.Inverse(geometry.Point{Lng: 0, Lat: 91}, flindersPeak)
+	if err == nil {
+		t.Errorf("Inverse expected an error for an invalid latitude")
+	}
+}
+
+func TestEllipsoidDirect(t *testing.T) {
+	got, finalBearing := GRS80.Direct(flindersPeak, 54972.271, 306+52.0/60+5.37/3600)
+	if math.Abs(got.Lng-buninyong.Lng) > 1e-8 || math.Abs(got.Lat-buninyong.Lat) > 1e-8 {
+		t.Errorf("Direct() = %v, want %v", got, buninyong)
+	}
+	if math.Abs(finalBearing-(127+10.0/60+25.07/3600-180)) > 0.01/3600 {
+		t.Errorf("Direct final bearing = %v", finalBearing)
+	}
+
+	got, _ = WGS84.Direct(geometry.Point{Lng: 179.5, Lat: 0}, 111319.49079327357, 90)
+	if math.Abs(got.Lng+179.5) > 1e-9 || math.Abs(got.Lat) > 1e-9 {
+		t.Errorf("Direct() = %v, want [-179.5, 0]", got)
+	}
+}
+
+func TestEllipsoidDistance(t *testing.T) {
+	d, err := WGS84.Distance(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 1, Lat: 0}, constants.UnitMeters)
+	if err != nil {
+		t.Errorf("Distance error %v", err)
+	}
+	// one degree of the equator
+	if math.Abs(d-2*math.Pi*constants.WGS84SemiMajorAxis/360) > 1e-6 {
+		t.Errorf("Distance() = %v", d)
+	}
+
+	sphere := Ellipsoid{SemiMajorAxis: constants.EarthRadius}
+	d, err = sphere.Distance(flindersPeak, buninyong, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Distance error %v", err)
+	}
+	want, err := PointDistance(flindersPeak, buninyong, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("PointDistance error %v", err)
+	}
+	if math.Abs(d-want) > 1e-9 {
+		t.Errorf("Distance() = %v, want %v", d, want)
+	}
+
+	d, err = WGS84.Distance(geometry.Point{Lng: 0, Lat: 0.5}, geometry.Point{Lng: 179.7, Lat: -0.5}, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Distance error %v", err)
+	}
+	if math.Abs(d-19995.624889961) > 1e-9 {
+		t.Errorf("Distance() = %v", d)
+	}
+
+	_, err = WGS84.Distance(flindersPeak, buninyong, "furlongs")
+	if err == nil {
+		t.Errorf("Distance expected an error for invalid units")
+	}
+}
+
+func TestEllipsoidDestination(t *testing.T) {
+	d, err := WGS84.Distance(flindersPeak, buninyong, constants.UnitMiles)
+	if err != nil {
+		t.Errorf("Distance error %v", err)
+	}
+	_, bearing, _, err := WGS84.Inverse(flindersPeak, buninyong)
+	if err != nil {
+		t.Errorf("Inverse error %v", err)
+	}
+	dest, err := WGS84.Destination(flindersPeak, d, bearing, constants.UnitMiles)
+	if err != nil {
+		t.Errorf("Destination error %v", err)
+	}
+	// sub-millimetre round trip
+	assert.Equal(t, math.Abs(dest.Lng-buninyong.Lng) < 1e-8, true)
+	assert.Equal(t, math.Abs(dest.Lat-buninyong.Lat) < 1e-8, true)
+}
+
+func TestEllipsoidLength(t *testing.T) {
+	ln := geometry.LineString{Coordinates: []geometry.Point{flindersPeak, buninyong, flindersPeak}}
+	l, err := GRS80.Length(ln, constants.UnitMeters)
+	if err != nil {
+		t.Errorf("Length error %v", err)
+	}
+	if math.Abs(l-2*54972.271) > 0.002 {
+		t.Errorf("Length() = %v", l)
+	}
+
+	// around the earth through nearly antipodal points
+	ln = geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0.5}, {Lng: 179.7, Lat: -0.5}, {Lng: 0, Lat: 0.5}}}
+	l, err = WGS84.Length(ln, constants.UnitMeters)
+	if err != nil {
+		t.Errorf("Length error %v", err)
+	}
+	if math.Abs(l-2*19995624.889961) > 1e-5 {
+		t.Errorf("Length() = %v", l)
+	}
+}
+
+func TestEllipsoidArea(t *testing.T) {
+	// an eighth of the ellipsoid, whose surface is 510065621724088 m²
+	octant := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 90, Lat: 0}, {Lng: 90, Lat: 90}, {Lng: 0, Lat: 90}, {Lng: 0, Lat: 0},
+	}}}}
+	a, err := WGS84.Area(&octant)
+	if err != nil {
+		t.Errorf("Area error %v", err)
+	}
+	if math.Abs(a-510065621724088/8.0) > 1 {
+		t.Errorf("Area() = %v", a)
+	}
+
+	sphere := Ellipsoid{SemiMajorAxis: constants.EarthRadius}
+	got, err := sphere.Area(&octant)
+	if err != nil {
+		t.Errorf("Area error %v", err)
+	}
+	want, err := Area(&octant)
+	if err != nil {
+		t.Errorf("Area error %v", err)
+	}
+	if math.Abs(got-want) > want*1e-12 {
+		t.Errorf("Area() = %v, want %v", got, want)
+	}
+
+	// a triangle whose edges are great circles has the spherical excess of its sides on the sphere
+	triangle := []geometry.Point{{Lng: 10, Lat: 10}, {Lng: 40, Lat: 20}, {Lng: 20, Lat: 50}, {Lng: 10, Lat: 10}}
+	got, err = sphere.Area(&geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: triangle}}})
+	if err != nil {
+		t.Errorf("Area error %v", err)
+	}
+	var sides []float64
+	for i := 1; i < len(triangle); i++ {
+		d, err := PointDistance(triangle[i-1], triangle[i], constants.UnitKilometers)
+		if err != nil {
+			t.Errorf("PointDistance error %v", err)
+		}
+		sides = append(sides, d*1000/constants.EarthRadius)
+	}
+	// L'Huilier's theorem
+	semi := (sides[0] + sides[1] + sides[2]) / 2
+	excess := 4 * math.Atan(math.Sqrt(math.Tan(semi/2)*math.Tan((semi-sides[0])/2)*math.Tan((semi-sides[1])/2)*math.Tan((semi-sides[2])/2)))
+	want = excess * constants.EarthRadius * constants.EarthRadius
+	if math.Abs(got-want) > want*1e-9 {
+		t.Errorf("Area() = %v, want %v", got, want)
+	}
+
+	// the area of the rings doesn't change when their edges are split along the geodesics
+	tests := map[string]struct {
+		ring []geometry.Point
+	}{
+		"triangle": {
+			ring: triangle,
+		},
+		"antimeridian": {
+			ring: []geometry.Point{{Lng: 170, Lat: -10}, {Lng: -170, Lat: -10}, {Lng: -170, Lat: 10}, {Lng: 170, Lat: 10}, {Lng: 170, Lat: -10}},
+		},
+		"pole": {
+			ring: []geometry.Point{{Lng: 0, Lat: 60}, {Lng: 120, Lat: 60}, {Lng: -120, Lat: 60}, {Lng: 0, Lat: 60}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var dense []geometry.Point
+			for i := 1; i < len(tt.ring); i++ {
+				d, bearing, _, err := WGS84.Inverse(tt.ring[i-1], tt.ring[i])
+				if err != nil {
+					t.Fatalf("Inverse error %v", err)
+				}
+				for k := 0; k < 10; k++ {
+					p, _ := WGS84.Direct(tt.ring[i-1], d*float64(k)/10, bearing)
+					dense = append(dense, p)
+				}
+			}
+			dense = append(dense, tt.ring[0])
+
+			want, err := WGS84.Area(&geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: tt.ring}}})
+			if err != nil {
+				t.Fatalf("Area error %v", err)
+			}
+			got, err := WGS84.Area(&geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: dense}}})
+			if err != nil {
+				t.Fatalf("Area error %v", err)
+			}
+			if math.Abs(got-want) > want*1e-9 {
+				t.Errorf("Area() = %v, want %v", got, want)
+			}
+		})
+	}
+
+	// a rectangle across the antimeridian has the area of the same rectangle around the prime meridian
+	got, err = WGS84.Area(&geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: tests["antimeridian"].ring}}})
+	if err != nil {
+		t.Errorf("Area error %v", err)
+	}
+	want, err = WGS84.Area(&geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: -10, Lat: -10}, {Lng: 10, Lat: -10}, {Lng: 10, Lat: 10}, {Lng: -10, Lat: 10}, {Lng: -10, Lat: -10},
+	}}}})
+	if err != nil {
+		t.Errorf("Area error %v", err)
+	}
+	if math.Abs(got-want) > 1e-3 {
+		t.Errorf("Area() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,860 @@
+package measurement
+
+import (
+	"math"
+
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// The inverse geodesic problem and the area of geodesic polygons are solved with the algorithms of C. F. F. Karney,
+// "Algorithms for geodesics", J. Geodesy 87, 43–55 (2013), https://doi.org/10.1007/s00190-012-0578-z, as implemented
+// in GeographicLib https://geographiclib.sourceforge.io, which converge for all pairs of points including the nearly
+// antipodal ones and are accurate to a few nanometres.
+
+// geodesicOrder is the order of the series expansions in the third flattening.
+const geodesicOrder = 6
+
+var (
+	geodesicTiny = math.Sqrt(math.SmallestNonzeroFloat64 * (1 << 52))
+	geodesicTol0 = math.Nextafter(1, 2) - 1
+	geodesicTol1 = 200 * geodesicTol0
+	geodesicTol2 = math.Sqrt(geodesicTol0)
+	geodesicTolb = geodesicTol0 * geodesicTol1
+	// geodesicXThresh is the distance from the antipodal point below which the starting guess isn't refined.
+	geodesicXThresh = 1000 * geodesicTol2
+)
+
+const (
+	// geodesicMaxit1 is the number of Newton iterations before falling back to bisection.
+	geodesicMaxit1 = 20
+	geodesicMaxit2 = geodesicMaxit1 + 53 + 10
+)
+
+// geodesic holds the constants of an ellipsoid and the coefficients of the series expansions.
+type geodesic struct {
+	a, f, f1, e2, ep2, n, b float64
+	// c2 is the square of the authalic radius.
+	c2    float64
+	etol2 float64
+	a3x   [geodesicOrder]float64
+	c3x   [geodesicOrder * (geodesicOrder - 1) / 2]float64
+	c4x   [geodesicOrder * (geodesicOrder + 1) / 2]float64
+}
+
+// geodesicLine is the solution of the inverse problem.
+type geodesicLine struct {
+	// distance is the length of the geodesic in meters.
+	distance float64
+	// azi1 and azi2 are the azimuths of the geodesic at the two points in degrees from True North.
+	azi1, azi2 float64
+	// area is the area in square meters of the quadrilateral bounded by the geodesic, the meridians of the points and
+	// the equator, measured counterclockwise from the first point.
+	area float64
+}
+
+func newGeodesic(e Ellipsoid) *geodesic {
+	g := &geodesic{a: e.SemiMajorAxis, f: e.Flattening}
+	g.f1 = 1 - g.f
+	g.e2 = g.f * (2 - g.f)
+	g.ep2 = g.e2 / (g.f1 * g.f1)
+	g.n = g.f / (2 - g.f)
+	g.b = g.a * g.f1
+	switch {
+	case g.e2 == 0:
+		g.c2 = (g.a*g.a + g.b*g.b) / 2
+	case g.e2 > 0:
+		g.c2 = (g.a*g.a + g.b*g.b*math.Atanh(math.Sqrt(g.e2))/math.Sqrt(g.e2)) / 2
+	default:
+		g.c2 = (g.a*g.a + g.b*g.b*math.Atan(math.Sqrt(-g.e2))/math.Sqrt(-g.e2)) / 2
+	}
+	g.etol2 = 0.1 * geodesicTol2 / math.Sqrt(math.Max(0.001, math.Abs(g.f))*math.Min(1, 1-g.f/2)/2)
+	g.a3coeff()
+	g.c3coeff()
+	g.c4coeff()
+	return g
+}
+
+// inverse solves the inverse geodesic problem between the points given by their latitudes and longitudes in degrees.
+func (g *geodesic) inverse(lat1, lon1, lat2, lon2 float64) geodesicLine {
+	var line geodesicLine
+
+	// the longitude difference in [-180, 180] with its rounding error
+	lon12, lon12s := angDiff(lon1, lon2)
+	lonsign := 1.0
+	if lon12 < 0 {
+		lonsign = -1
+	}
+	lon12 = lonsign * angRound(lon12)
+	lon12s = angRound((180 - lon12) - lonsign*lon12s)
+	lam12 := lon12 * math.Pi / 180
+	var slam12, clam12 float64
+	if lon12 > 90 {
+		slam12, clam12 = sincosd(lon12s)
+		clam12 = -clam12
+	} else {
+		slam12, clam12 = sincosd(lon12)
+	}
+
+	// treat the points very close to the equator as on it
+	lat1 = angRound(latFix(lat1))
+	lat2 = angRound(latFix(lat2))
+	// swap the points so that the first one has the greater absolute latitude, and make it negative
+	swapp := 1.0
+	if math.Abs(lat1) < math.Abs(lat2) {
+		swapp = -1
+		lonsign = -lonsign
+		lat1, lat2 = lat2, lat1
+	}
+	latsign := -1.0
+	if lat1 < 0 {
+		latsign = 1
+	}
+	lat1 *= latsign
+	lat2 *= latsign
+
+	// the reduced latitudes
+	sbet1, cbet1 := sincosd(lat1)
+	sbet1 *= g.f1
+	sbet1, cbet1 = norm2(sbet1, cbet1)
+	cbet1 = math.Max(geodesicTiny, cbet1)
+	sbet2, cbet2 := sincosd(lat2)
+	sbet2 *= g.f1
+	sbet2, cbet2 = norm2(sbet2, cbet2)
+	cbet2 = math.Max(geodesicTiny, cbet2)
+
+	// force bet2 = ±bet1 exactly when their difference vanishes
+	if cbet1 < -sbet1 {
+		if cbet2 == cbet1 {
+			sbet2 = math.Copysign(sbet1, sbet2)
+		}
+	} else if math.Abs(sbet2) == -sbet1 {
+		cbet2 = cbet1
+	}
+
+	dn1 := math.Sqrt(1 + g.ep2*sbet1*sbet1)
+	dn2 := math.Sqrt(1 + g.ep2*sbet2*sbet2)
+
+	var c1a, c2a [geodesicOrder + 1]float64
+	var c3a [geodesicOrder]float64
+
+	var sig12, s12x, calp1, salp1, calp2, salp2, omg12 float64
+	somg12, comg12 := 2.0, 0.0
+
+	meridian := lat1 == -90 || slam12 == 0
+	if meridian {
+		// the points are on a meridian and the geodesic may follow it
+		calp1, salp1 = clam12, slam12
+		calp2, salp2 = 1, 0
+		ssig1, csig1 := sbet1, calp1*cbet1
+		ssig2, csig2 := sbet2, calp2*cbet2
+		sig12 = math.Atan2(math.Max(0, csig1*ssig2-ssig1*csig2), csig1*csig2+ssig1*ssig2)
+		s12b, m12b, _ := g.lengths(g.n, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2, c1a[:], c2a[:])
+		// a meridian longer than half of it isn't the shortest path
+		if sig12 < 1 || m12b >= 0 {
+			if sig12 < 3*geodesicTiny || (sig12 < geodesicTol0 && (s12b < 0 || m12b < 0)) {
+				sig12, s12b = 0, 0
+			}
+			s12x = s12b * g.b
+		} else {
+			meridian = false
+		}
+	}
+
+	if !meridian && sbet1 == 0 && (g.f <= 0 || lon12s >= g.f*180) {
+		// the geodesic runs along the equator
+		calp1, calp2 = 0, 0
+		salp1, salp2 = 1, 1
+		s12x = g.a * lam12
+		sig12 = lam12 / g.f1
+		omg12 = sig12
+	} else if !meridian {
+		start := g.inverseStart(sbet1, cbet1, dn1, sbet2, cbet2, dn2, lam12, slam12, clam12, c1a[:], c2a[:])
+		sig12, salp1, calp1 = start.sig12, start.salp1, start.calp1
+		if sig12 >= 0 {
+			// a short line solved by the starting guess
+			salp2, calp2 = start.salp2, start.calp2
+			s12x = sig12 * g.b * start.dnm
+			omg12 = lam12 / (g.f1 * start.dnm)
+		} else {
+			// Newton's method on alp1, keeping the root bracketed by (alp1a, alp1b)
+			var l lambda12
+			salp1a, calp1a, salp1b, calp1b := geodesicTiny, 1.0, geodesicTiny, -1.0
+			tripn, tripb := false, false
+			for numit := 0; numit < geodesicMaxit2; numit++ {
+				l = g.lambda12(sbet1, cbet1, dn1, sbet2, cbet2, dn2, salp1, calp1, slam12, clam12,
+					numit < geodesicMaxit1, c1a[:], c2a[:], c3a[:])
+				v := l.lam12
+				tol := 1.0
+				if tripn {
+					tol = 8
+				}
+				if tripb || !(math.Abs(v) >= tol*geodesicTol0) {
+					break
+				}
+				if v > 0 && (numit < geodesicMaxit1 || calp1/salp1 > calp1b/salp1b) {
+					salp1b, calp1b = salp1, calp1
+				} else if v < 0 && (numit < geodesicMaxit1 || calp1/salp1 < calp1a/salp1a) {
+					salp1a, calp1a = salp1, calp1
+				}
+				if numit < geodesicMaxit1 && l.dlam12 > 0 {
+					dalp1 := -v / l.dlam12
+					sdalp1, cdalp1 := math.Sincos(dalp1)
+					nsalp1 := salp1*cdalp1 + calp1*sdalp1
+					if nsalp1 > 0 && math.Abs(dalp1) < math.Pi {
+						calp1 = calp1*cdalp1 - salp1*sdalp1
+						salp1 = nsalp1
+						salp1, calp1 = norm2(salp1, calp1)
+						tripn = math.Abs(v) <= 16*geodesicTol0
+						continue
+					}
+				}
+				// bisect the bracket when Newton's method leaves it
+				salp1 = (salp1a + salp1b) / 2
+				calp1 = (calp1a + calp1b) / 2
+				salp1, calp1 = norm2(salp1, calp1)
+				tripn = false
+				tripb = math.Abs(salp1a-salp1)+(calp1a-calp1) < geodesicTolb ||
+					math.Abs(salp1-salp1b)+(calp1-calp1b) < geodesicTolb
+			}
+			salp2, calp2, sig12 = l.salp2, l.calp2, l.sig12
+			s12b, _, _ := g.lengths(l.eps, sig12, l.ssig1, l.csig1, dn1, l.ssig2, l.csig2, dn2, c1a[:], c2a[:])
+			s12x = s12b * g.b
+			sdomg12, cdomg12 := math.Sincos(l.domg12)
+			somg12 = slam12*cdomg12 - clam12*sdomg12
+			comg12 = clam12*cdomg12 + slam12*sdomg12
+		}
+	}
+	line.distance = 0 + s12x
+
+	// the area between the geodesic and the equator
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+	if calp0 != 0 && salp0 != 0 {
+		ssig1, csig1 := norm2(sbet1, calp1*cbet1)
+		ssig2, csig2 := norm2(sbet2, calp2*cbet2)
+		k2 := calp0 * calp0 * g.ep2
+		eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+		a4 := g.a * g.a * calp0 * salp0 * g.e2
+		var c4a [geodesicOrder]float64
+		g.c4f(eps, c4a[:])
+		line.area = a4 * (sinCosSeries(false, ssig2, csig2, c4a[:]) - sinCosSeries(false, ssig1, csig1, c4a[:]))
+	}
+	if !meridian && somg12 > 1 {
+		somg12, comg12 = math.Sincos(omg12)
+	}
+	var alp12 float64
+	if !meridian && comg12 > -0.7071 && sbet2-sbet1 < 1.75 {
+		// tan(alp12/2) from the longitude difference when it isn't too large
+		domg12 := 1 + comg12
+		dbet1 := 1 + cbet1
+		dbet2 := 1 + cbet2
+		alp12 = 2 * math.Atan2(somg12*(sbet1*dbet2+sbet2*dbet1), domg12*(sbet1*sbet2+dbet1*dbet2))
+	} else {
+		salp12 := salp2*calp1 - calp2*salp1
+		calp12 := calp2*calp1 + salp2*salp1
+		if salp12 == 0 && calp12 < 0 {
+			salp12 = geodesicTiny * calp1
+			calp12 = -1
+		}
+		alp12 = math.Atan2(salp12, calp12)
+	}
+	line.area += g.c2 * alp12
+	line.area *= swapp * lonsign * latsign
+	line.area += 0
+
+	// undo the swaps and the sign changes
+	if swapp < 0 {
+		salp1, salp2 = salp2, salp1
+		calp1, calp2 = calp2, calp1
+	}
+	salp1 *= swapp * lonsign
+	calp1 *= swapp * latsign
+	salp2 *= swapp * lonsign
+	calp2 *= swapp * latsign
+	line.azi1 = atan2d(salp1, calp1)
+	line.azi2 = atan2d(salp2, calp2)
+	return line
+}
+
+// lengths returns the distance and the reduced length divided by b, and m0, of the geodesic with angular length
+// sig12 on the auxiliary sphere.
+func (g *geodesic) lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2 float64, c1a, c2a []float64) (float64, float64, float64) {
+	a1 := a1m1f(eps)
+	c1f(eps, c1a)
+	a2 := a2m1f(eps)
+	c2f(eps, c2a)
+	m0 := a1 - a2
+	a1++
+	a2++
+	b1 := sinCosSeries(true, ssig2, csig2, c1a) - sinCosSeries(true, ssig1, csig1, c1a)
+	s12b := a1 * (sig12 + b1)
+	b2 := sinCosSeries(true, ssig2, csig2, c2a) - sinCosSeries(true, ssig1, csig1, c2a)
+	j12 := m0*sig12 + (a1*b1 - a2*b2)
+	m12b := dn2*(csig1*ssig2) - dn1*(ssig1*csig2) - csig1*csig2*j12
+	return s12b, m12b, m0
+}
+
+// inverseStartValues is the starting guess of Newton's method, or the solution of a short line when sig12 isn't
+// negative.
+type inverseStartValues struct {
+	sig12, salp1, calp1, salp2, calp2, dnm float64
+}
+
+func (g *geodesic) inverseStart(sbet1, cbet1, dn1, sbet2, cbet2, dn2, lam12, slam12, clam12 float64, c1a, c2a []float64) inverseStartValues {
+	v := inverseStartValues{sig12: -1}
+	// bet12 = bet2 - bet1 in [0, pi), bet12a = bet2 + bet1 in (-pi, 0]
+	sbet12 := sbet2*cbet1 - cbet2*sbet1
+	cbet12 := cbet2*cbet1 + sbet2*sbet1
+	sbet12a := sbet2 * cbet1
+	sbet12a += cbet2 * sbet1
+
+	shortline := cbet12 >= 0 && sbet12 < 0.5 && cbet2*lam12 < 0.5
+	var somg12, comg12 float64
+	if shortline {
+		sbetm2 := (sbet1 + sbet2) * (sbet1 + sbet2)
+		sbetm2 /= sbetm2 + (cbet1+cbet2)*(cbet1+cbet2)
+		v.dnm = math.Sqrt(1 + g.ep2*sbetm2)
+		somg12, comg12 = math.Sincos(lam12 / (g.f1 * v.dnm))
+	} else {
+		somg12, comg12 = slam12, clam12
+	}
+
+	v.salp1 = cbet2 * somg12
+	if comg12 >= 0 {
+		v.calp1 = sbet12 + cbet2*sbet1*somg12*somg12/(1+comg12)
+	} else {
+		v.calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+	}
+
+	ssig12 := math.Hypot(v.salp1, v.calp1)
+	csig12 := sbet1*sbet2 + cbet1*cbet2*comg12
+	if shortline && ssig12 < g.etol2 {
+		// a really short line
+		v.salp2 = cbet1 * somg12
+		if comg12 >= 0 {
+			v.calp2 = sbet12 - cbet1*sbet2*(somg12*somg12/(1+comg12))
+		} else {
+			v.calp2 = sbet12 - cbet1*sbet2*(1-comg12)
+		}
+		v.salp2, v.calp2 = norm2(v.salp2, v.calp2)
+		v.sig12 = math.Atan2(ssig12, csig12)
+	} else if math.Abs(g.n) > 0.1 || csig12 >= 0 || ssig12 >= 6*math.Abs(g.n)*math.Pi*cbet1*cbet1 {
+		// the spherical approximation is good enough
+	} else {
+		// scale lam12 and bet2 to coordinates where the antipodal point is at the origin and the singular point
+		// at y = 0, x = -1
+		lam12x := math.Atan2(-slam12, -clam12)
+		var x, y, lamscale, betscale float64
+		if g.f >= 0 {
+			k2 := sbet1 * sbet1 * g.ep2
+			eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+			lamscale = g.f * cbet1 * g.a3f(eps) * math.Pi
+			betscale = lamscale * cbet1
+			x = lam12x / lamscale
+			y = sbet12a / betscale
+		} else {
+			cbet12a := cbet2*cbet1 - sbet2*sbet1
+			bet12a := math.Atan2(sbet12a, cbet12a)
+			_, m12b, m0 := g.lengths(g.n, math.Pi+bet12a, sbet1, -cbet1, dn1, sbet2, cbet2, dn2, c1a, c2a)
+			x = -1 + m12b/(cbet1*cbet2*m0*math.Pi)
+			if x < -0.01 {
+				betscale = sbet12a / x
+			} else {
+				betscale = -g.f * cbet1 * cbet1 * math.Pi
+			}
+			lamscale = betscale / cbet1
+			y = lam12x / lamscale
+		}
+
+		if y > -geodesicTol1 && x > -1-geodesicXThresh {
+			// strip near the cut
+			if g.f >= 0 {
+				v.salp1 = math.Min(1, -x)
+				v.calp1 = -math.Sqrt(1 - v.salp1*v.salp1)
+			} else {
+				if x > -geodesicTol1 {
+					v.calp1 = math.Max(0, x)
+				} else {
+					v.calp1 = math.Max(-1, x)
+				}
+				v.salp1 = math.Sqrt(1 - v.calp1*v.calp1)
+			}
+		} else {
+			k := astroid(x, y)
+			var omg12a float64
+			if g.f >= 0 {
+				omg12a = lamscale * (-x * k / (1 + k))
+			} else {
+				omg12a = lamscale * (-y * (1 + k) / k)
+			}
+			somg12, comg12 = math.Sincos(omg12a)
+			comg12 = -comg12
+			v.salp1 = cbet2 * somg12
+			v.calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+		}
+	}
+	// the backwards check lets NaN through
+	if !(v.salp1 <= 0) {
+		v.salp1, v.calp1 = norm2(v.salp1, v.calp1)
+	} else {
+		v.salp1, v.calp1 = 1, 0
+	}
+	return v
+}
+
+// lambda12 is the longitude difference reached by the geodesic leaving with the azimuth alp1, less the wanted one,
+// and its derivative.
+type lambda12 struct {
+	lam12, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, domg12, dlam12 float64
+}
+
+func (g *geodesic) lambda12(sbet1, cbet1, dn1, sbet2, cbet2, dn2, salp1, calp1, slam120, clam120 float64, diffp bool, c1a, c2a, c3a []float64) lambda12 {
+	var l lambda12
+	if sbet1 == 0 && calp1 == 0 {
+		// break the degeneracy of the equatorial line
+		calp1 = -geodesicTiny
+	}
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+
+	l.ssig1 = sbet1
+	somg1 := salp0 * sbet1
+	l.csig1 = calp1 * cbet1
+	comg1 := l.csig1
+	l.ssig1, l.csig1 = norm2(l.ssig1, l.csig1)
+
+	// enforce the symmetries when |bet2| = -bet1
+	if cbet2 != cbet1 {
+		l.salp2 = salp0 / cbet2
+	} else {
+		l.salp2 = salp1
+	}
+	if cbet2 != cbet1 || math.Abs(sbet2) != -sbet1 {
+		t := (sbet1 - sbet2) * (sbet1 + sbet2)
+		if cbet1 < -sbet1 {
+			t = (cbet2 - cbet1) * (cbet1 + cbet2)
+		}
+		l.calp2 = math.Sqrt((calp1*cbet1)*(calp1*cbet1)+t) / cbet2
+	} else {
+		l.calp2 = math.Abs(calp1)
+	}
+	l.ssig2 = sbet2
+	somg2 := salp0 * sbet2
+	l.csig2 = l.calp2 * cbet2
+	comg2 := l.csig2
+	l.ssig2, l.csig2 = norm2(l.ssig2, l.csig2)
+
+	l.sig12 = math.Atan2(math.Max(0, l.csig1*l.ssig2-l.ssig1*l.csig2), l.csig1*l.csig2+l.ssig1*l.ssig2)
+	somg12 := math.Max(0, comg1*somg2-somg1*comg2)
+	comg12 := comg1*comg2 + somg1*somg2
+	eta := math.Atan2(somg12*clam120-comg12*slam120, comg12*clam120+somg12*slam120)
+	k2 := calp0 * calp0 * g.ep2
+	l.eps = k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+	g.c3f(l.eps, c3a)
+	b312 := sinCosSeries(true, l.ssig2, l.csig2, c3a) - sinCosSeries(true, l.ssig1, l.csig1, c3a)
+	l.domg12 = -g.f * g.a3f(l.eps) * salp0 * (l.sig12 + b312)
+	l.lam12 = eta + l.domg12
+	if diffp {
+		if l.calp2 == 0 {
+			l.dlam12 = -2 * g.f1 * dn1 / sbet1
+		} else {
+			_, m12b, _ := g.lengths(l.eps, l.sig12, l.ssig1, l.csig1, dn1, l.ssig2, l.csig2, dn2, c1a, c2a)
+			l.dlam12 = m12b * g.f1 / (l.calp2 * cbet2)
+		}
+	}
+	return l
+}
+
+func (g *geodesic) a3f(eps float64) float64 {
+	return polyval(geodesicOrder-1, g.a3x[:], eps)
+}
+
+func (g *geodesic) c3f(eps float64, c []float64) {
+	mult := 1.0
+	o := 0
+	for l := 1; l < geodesicOrder; l++ {
+		p := geodesicOrder - l - 1
+		mult *= eps
+		c[l] = mult * polyval(p, g.c3x[o:], eps)
+		o += p + 1
+	}
+}
+
+func (g *geodesic) c4f(eps float64, c []float64) {
+	mult := 1.0
+	o := 0
+	for l := 0; l < geodesicOrder; l++ {
+		p := geodesicOrder - l - 1
+		c[l] = mult * polyval(p, g.c4x[o:], eps)
+		o += p + 1
+		mult *= eps
+	}
+}
+
+func (g *geodesic) a3coeff() {
+	coeff := []float64{
+		-3, 128,
+		-2, -3, 64,
+		-1, -3, -1, 16,
+		3, -1, -2, 8,
+		1, -1, 2,
+		1, 1,
+	}
+	o, k := 0, 0
+	for j := geodesicOrder - 1; j >= 0; j-- {
+		p := geodesicOrder - j - 1
+		if j < p {
+			p = j
+		}
+		g.a3x[k] = polyval(p, coeff[o:], g.n) / coeff[o+p+1]
+		k++
+		o += p + 2
+	}
+}
+
+func (g *geodesic) c3coeff() {
+	coeff := []float64{
+		3, 128,
+		2, 5, 128,
+		-1, 3, 3, 64,
+		-1, 0, 1, 8,
+		-1, 1, 4,
+		5, 256,
+		1, 3, 128,
+		-3, -2, 3, 64,
+		1, -3, 2, 32,
+		7, 512,
+		-10, 9, 384,
+		5, -9, 5, 192,
+		7, 512,
+		-14, 7, 512,
+		21, 2560,
+	}
+	o, k := 0, 0
+	for l := 1; l < geodesicOrder; l++ {
+		for j := geodesicOrder - 1; j >= l; j-- {
+			p := geodesicOrder - j - 1
+			if j < p {
+				p = j
+			}
+			g.c3x[k] = polyval(p, coeff[o:], g.n) / coeff[o+p+1]
+			k++
+			o += p + 2
+		}
+	}
+}
+
+func (g *geodesic) c4coeff() {
+	coeff := []float64{
+		97, 15015,
+		1088, 156, 45045,
+		-224, -4784, 1573, 45045,
+		-10656, 14144, -4576, -858, 45045,
+		64, 624, -4576, 6864, -3003, 15015,
+		100, 208, 572, 3432, -12012, 30030, 45045,
+		1, 9009,
+		-2944, 468, 135135,
+		5792, 1040, -1287, 135135,
+		5952, -11648, 9152, -2574, 135135,
+		-64, -624, 4576, -6864, 3003, 135135,
+		8, 10725,
+		1856, -936, 225225,
+		-8448, 4992, -1144, 225225,
+		-1440, 4160, -4576, 1716, 225225,
+		-136, 63063,
+		1024, -208, 105105,
+		3584, -3328, 1144, 315315,
+		-128, 135135,
+		-2560, 832, 405405,
+		128, 99099,
+	}
+	o, k := 0, 0
+	for l := 0; l < geodesicOrder; l++ {
+		for j := geodesicOrder - 1; j >= l; j-- {
+			p := geodesicOrder - j - 1
+			g.c4x[k] = polyval(p, coeff[o:], g.n) / coeff[o+p+1]
+			k++
+			o += p + 2
+		}
+	}
+}
+
+// a1m1f returns A1 - 1.
+func a1m1f(eps float64) float64 {
+	coeff := []float64{1, 4, 64, 0, 256}
+	p := geodesicOrder / 2
+	t := polyval(p, coeff, eps*eps) / coeff[p+1]
+	return (t + eps) / (1 - eps)
+}
+
+// a2m1f returns A2 - 1.
+func a2m1f(eps float64) float64 {
+	coeff := []float64{-11, -28, -192, 0, 256}
+	p := geodesicOrder / 2
+	t := polyval(p, coeff, eps*eps) / coeff[p+1]
+	return (t - eps) / (1 + eps)
+}
+
+func c1f(eps float64, c []float64) {
+	seriesCoefficients(eps, c, []float64{
+		-1, 6, -16, 32,
+		-9, 64, -128, 2048,
+		9, -16, 768,
+		3, -5, 512,
+		-7, 1280,
+		-7, 2048,
+	})
+}
+
+func c2f(eps float64, c []float64) {
+	seriesCoefficients(eps, c, []float64{
+		1, 2, 16, 32,
+		35, 64, 384, 2048,
+		15, 80, 768,
+		7, 35, 512,
+		63, 1280,
+		77, 2048,
+	})
+}
+
+// seriesCoefficients fills c[1:] with the coefficients of the series in eps given by the polynomials in eps².
+func seriesCoefficients(eps float64, c []float64, coeff []float64) {
+	eps2 := eps * eps
+	d := eps
+	o := 0
+	for l := 1; l <= geodesicOrder; l++ {
+		p := (geodesicOrder - l) / 2
+		c[l] = d * polyval(p, coeff[o:], eps2) / coeff[o+p+1]
+		o += p + 2
+		d *= eps
+	}
+}
+
+// sinCosSeries evaluates the sum of c[i] sin(2ix), or of c[i] cos((2i+1)x), with Clenshaw summation.
+func sinCosSeries(sinp bool, sinx, cosx float64, c []float64) float64 {
+	k := len(c)
+	n := k
+	if sinp {
+		n--
+	}
+	ar := 2 * (cosx - sinx) * (cosx + sinx)
+	y0, y1 := 0.0, 0.0
+	if n&1 != 0 {
+		k--
+		y0 = c[k]
+	}
+	for n /= 2; n > 0; n-- {
+		k--
+		y1 = ar*y0 - y1 + c[k]
+		k--
+		y0 = ar*y1 - y0 + c[k]
+	}
+	if sinp {
+		return 2 * sinx * cosx * y0
+	}
+	return cosx * (y0 - y1)
+}
+
+// astroid solves k⁴ + 2k³ - (x² + y² - 1)k² - 2y²k - y² = 0 for its positive root.
+func astroid(x, y float64) float64 {
+	p := x * x
+	q := y * y
+	r := (p + q - 1) / 6
+	if q == 0 && r <= 0 {
+		return 0
+	}
+	s := p * q / 4
+	r2 := r * r
+	r3 := r * r2
+	disc := s * (s + 2*r3)
+	u := r
+	if disc >= 0 {
+		t3 := s + r3
+		if t3 < 0 {
+			t3 -= math.Sqrt(disc)
+		} else {
+			t3 += math.Sqrt(disc)
+		}
+		t := math.Cbrt(t3)
+		u += t
+		if t != 0 {
+			u += r2 / t
+		}
+	} else {
+		ang := math.Atan2(math.Sqrt(-disc), -(s + r3))
+		u += 2 * r * math.Cos(ang/3)
+	}
+	v := math.Sqrt(u*u + q)
+	var uv float64
+	if u < 0 {
+		uv = q / (v - u)
+	} else {
+		uv = u + v
+	}
+	w := (uv - q) / (2 * v)
+	return uv / (math.Sqrt(uv+w*w) + w)
+}
+
+// polyval evaluates the polynomial of degree n whose coefficients, highest first, start p.
+func polyval(n int, p []float64, x float64) float64 {
+	if n < 0 {
+		return 0
+	}
+	y := p[0]
+	for i := 1; i <= n; i++ {
+		y = y*x + p[i]
+	}
+	return y
+}
+
+// twoSum returns the sum of u and v and its rounding error.
+func twoSum(u, v float64) (float64, float64) {
+	s := u + v
+	up := s - v
+	vpp := s - up
+	up -= u
+	vpp -= v
+	return s, -(up + vpp)
+}
+
+// angNormalize reduces the angle in degrees to [-180, 180].
+func angNormalize(x float64) float64 {
+	y := math.Remainder(x, 360)
+	if math.Abs(y) == 180 {
+		return math.Copysign(180, x)
+	}
+	return y
+}
+
+// angDiff returns y - x reduced to [-180, 180] and its rounding error.
+func angDiff(x, y float64) (float64, float64) {
+	d, t := twoSum(angNormalize(-x), angNormalize(y))
+	d = angNormalize(d)
+	if d == 180 && t > 0 {
+		d = -180
+	}
+	return twoSum(d, t)
+}
+
+// angRound rounds the tiny angles so that they're exact multiples of 2⁻⁵⁷ degrees.
+func angRound(x float64) float64 {
+	const z = 1.0 / 16
+	y := math.Abs(x)
+	if y < z {
+		y = z - (z - y)
+	}
+	return math.Copysign(y, x)
+}
+
+// latFix returns NaN for latitudes out of [-90, 90].
+func latFix(x float64) float64 {
+	if math.Abs(x) > 90 {
+		return math.NaN()
+	}
+	return x
+}
+
+// sincosd returns the sine and the cosine of the angle in degrees, exact for multiples of 90°.
+func sincosd(x float64) (float64, float64) {
+	r := math.Mod(x, 360)
+	q := int(math.Round(r / 90))
+	r -= 90 * float64(q)
+	s, c := math.Sincos(r * math.Pi / 180)
+	var sinx, cosx float64
+	switch ((q % 4) + 4) % 4 {
+	case 0:
+		sinx, cosx = s, c
+	case 1:
+		sinx, cosx = c, -s
+	case 2:
+		sinx, cosx = -s, -c
+	default:
+		sinx, cosx = -c, s
+	}
+	if x != 0 {
+		sinx += 0
+		cosx += 0
+	}
+	return sinx, cosx
+}
+
+// atan2d returns the angle in degrees whose tangent is y/x, exact for multiples of 90°.
+func atan2d(y, x float64) float64 {
+	q := 0
+	if math.Abs(y) > math.Abs(x) {
+		x, y = y, x
+		q = 2
+	}
+	if x < 0 {
+		x = -x
+		q++
+	}
+	ang := math.Atan2(y, x) * 180 / math.Pi
+	switch q {
+	case 1:
+		if y >= 0 {
+			ang = 180 - ang
+		} else {
+			ang = -180 - ang
+		}
+	case 2:
+		ang = 90 - ang
+	case 3:
+		ang = -90 + ang
+	}
+	return ang
+}
+
+// norm2 scales the vector to unit length.
+func norm2(x, y float64) (float64, float64) {
+	r := math.Hypot(x, y)
+	return x / r, y / r
+}
+
+// transit returns 1 or -1 if the edge crosses the prime meridian eastwards or westwards, and 0 otherwise.
+func transit(lon1, lon2 float64) int {
+	lon1 = angNormalize(lon1)
+	lon2 = angNormalize(lon2)
+	lon12, _ := angDiff(lon1, lon2)
+	if lon1 <= 0 && lon2 > 0 && lon12 > 0 {
+		return 1
+	}
+	if lon2 <= 0 && lon1 > 0 && lon12 < 0 {
+		return -1
+	}
+	return 0
+}
+
+// ringArea returns the area of the ring whose edges are geodesics, positive if it is counterclockwise, between minus
+// and plus half the surface of the ellipsoid.
+func (g *geodesic) ringArea(coords []geometry.Point) float64 {
+	area := 0.0
+	crossings := 0
+	for i := 1; i < len(coords); i++ {
+		area += g.inverse(coords[i-1].Lat, coords[i-1].Lng, coords[i].Lat, coords[i].Lng).area
+		crossings += transit(coords[i-1].Lng, coords[i].Lng)
+	}
+	// close the ring
+	if n := len(coords); n > 1 && (coords[0].Lng != coords[n-1].Lng || coords[0].Lat != coords[n-1].Lat) {
+		area += g.inverse(coords[n-1].Lat, coords[n-1].Lng, coords[0].Lat, coords[0].Lng).area
+		crossings += transit(coords[n-1].Lng, coords[0].Lng)
+	}
+
+	area0 := 4 * math.Pi * g.c2
+	area = math.Remainder(area, area0)
+	if crossings&1 != 0 {
+		if area < 0 {
+			area += area0 / 2
+		} else {
+			area -= area0 / 2
+		}
+	}
+	// the sum is clockwise
+	area = -area
+	if area > area0/2 {
+		area -= area0
+	} else if area <= -area0/2 {
+		area += area0
+	}
+	return 0 + area
+}
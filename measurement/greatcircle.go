@@ -0,0 +1,88 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// GreatCircle calculates the great circle route between two points and returns it as a LineString with npoints
+// vertices, the start and the end included. If the route crosses the antimeridian it is split there and returned
+// as a MultiLineString.
+// https://en.wikipedia.org/wiki/Great-circle_distance
+func GreatCircle(start geometry.Point, end geometry.Point, npoints int) (geometry.Object, error) {
+	if npoints < 2 {
+		return nil, errors.New("npoints must be at least 2")
+	}
+	d, err := PointDistance(start, end, constants.UnitRadians)
+	if err != nil {
+		return nil, err
+	}
+	if math.Abs(d-math.Pi) < 1e-6 {
+		return nil, errors.New("the great circle of antipodal points is undefined")
+	}
+	bearing := PointBearing(start, end)
+
+	coords := make([]geometry.Point, npoints)
+	coords[0] = start
+	coords[npoints-1] = end
+	for i := 1; i < npoints-1; i++ {
+		p, err := Destination(start, d*float64(i)/float64(npoints-1), bearing, constants.UnitRadians)
+		if err != nil {
+			return nil, err
+		}
+		// normalise to −180..+180°
+		p.Lng = math.Mod(p.Lng+540, 360) - 180
+		coords[i] = *p
+	}
+
+	lines := splitAtAntimeridian(coords)
+	if len(lines) == 1 {
+		ln, err := geometry.NewLineString(lines[0].Coordinates)
+		if err != nil {
+			return nil, err
+		}
+		return ln, nil
+	}
+	mln, err := geometry.NewMultiLineString(lines)
+	if err != nil {
+		return nil, err
+	}
+	return mln, nil
+}
+
+// splitAtAntimeridian splits the line wherever two consecutive vertices are on opposite sides of the antimeridian,
+// adding a vertex on the antimeridian at the end of the first part and at the start of the next.
+func splitAtAntimeridian(coords []geometry.Point) []geometry.LineString {
+	var lines []geometry.LineString
+	current := []geometry.Point{coords[0]}
+	for i := 1; i < len(coords); i++ {
+		prev := coords[i-1]
+		p := coords[i]
+		offset := antimeridianOffset(prev.Lng, p.Lng)
+		if offset == 0 {
+			current = append(current, p)
+			continue
+		}
+
+		// the longitude of the antimeridian on the side of the previous vertex
+		meridian := 180.0
+		if offset < 0 {
+			meridian = -180.0
+		}
+		ratio := (meridian - prev.Lng) / (p.Lng + offset - prev.Lng)
+		lat := prev.Lat + (p.Lat-prev.Lat)*ratio
+
+		// a vertex already on the antimeridian ends the part itself
+		if prev.Lng != meridian {
+			current = append(current, geometry.Point{Lng: meridian, Lat: lat})
+		}
+		if len(current) > 1 {
+			lines = append(lines, geometry.LineString{Coordinates: current})
+		}
+		current = []geometry.Point{{Lng: -meridian, Lat: lat}, p}
+	}
+	return append(lines, geometry.LineString{Coordinates: current})
+}
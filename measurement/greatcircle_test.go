@@ -0,0 +1,76 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+func TestGreatCircle(t *testing.T) {
+	start := geometry.Point{Lng: -122, Lat: 48}
+	end := geometry.Point{Lng: -77, Lat: 39}
+	o, err := GreatCircle(start, end, 10)
+	if err != nil {
+		t.Errorf("GreatCircle error %v", err)
+	}
+	ln, ok := o.(*geometry.LineString)
+	if !ok {
+		t.Fatalf("GreatCircle = %T, want *geometry.LineString", o)
+	}
+	assert.Equal(t, len(ln.Coordinates), 10)
+	assert.Equal(t, ln.Coordinates[0], start)
+	assert.Equal(t, ln.Coordinates[9], end)
+
+	// the vertices are equally spaced along the great circle
+	d, err := PointDistance(start, end, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("PointDistance error %v", err)
+	}
+	for i := 1; i < len(ln.Coordinates); i++ {
+		step, err := PointDistance(ln.Coordinates[i-1], ln.Coordinates[i], constants.UnitKilometers)
+		if err != nil {
+			t.Errorf("PointDistance error %v", err)
+		}
+		if math.Abs(step-d/9) > 1e-6 {
+			t.Errorf("step %v = %v, want %v", i, step, d/9)
+		}
+	}
+}
+
+func TestGreatCircleAcrossTheAntimeridian(t *testing.T) {
+	start := geometry.Point{Lng: 151.2, Lat: -33.9}
+	end := geometry.Point{Lng: -118.4, Lat: 33.9}
+	o, err := GreatCircle(start, end, 100)
+	if err != nil {
+		t.Errorf("GreatCircle error %v", err)
+	}
+	mln, ok := o.(*geometry.MultiLineString)
+	if !ok {
+		t.Fatalf("GreatCircle = %T, want *geometry.MultiLineString", o)
+	}
+	assert.Equal(t, len(mln.Coordinates), 2)
+
+	west := mln.Coordinates[0].Coordinates
+	east := mln.Coordinates[1].Coordinates
+	assert.Equal(t, west[0], start)
+	assert.Equal(t, east[len(east)-1], end)
+	assert.Equal(t, west[len(west)-1].Lng, 180.0)
+	assert.Equal(t, east[0].Lng, -180.0)
+	assert.Equal(t, west[len(west)-1].Lat, east[0].Lat)
+	// the vertices on the antimeridian are added to the interpolated ones
+	assert.Equal(t, len(west)+len(east), 102)
+}
+
+func TestGreatCircleErrors(t *testing.T) {
+	_, err := GreatCircle(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 10, Lat: 10}, 1)
+	if err == nil {
+		t.Errorf("GreatCircle expected an error for less than 2 points")
+	}
+	_, err = GreatCircle(geometry.Point{Lng: 0, Lat: 10}, geometry.Point{Lng: 180, Lat: -10}, 10)
+	if err == nil {
+		t.Errorf("GreatCircle expected an error for antipodal points")
+	}
+}
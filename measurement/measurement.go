@@ -21,6 +21,8 @@ func Distance(lon1 float64, lat1 float64, lon2 float64, lat2 float64, units stri
 	lat2R := conversions.DegreesToRadians(lat2)
 
 	a := math.Pow(math.Sin(dLat/2), 2) + math.Pow(math.Sin(dLng/2), 2)*math.Cos(lat1R)*math.Cos(lat2R)
+	// rounding can push a past 1 for antipodal points
+	a = math.Min(a, 1)
 	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
 	// d := constants.EarthRadius * c
 
@@ -90,22 +92,64 @@ func Destination(p1 geometry.Point, distance float64, bearing float64, units str
 }
 
 // Length measures the length of a geometry.
+// t can be any typed geometry.Object, a *geometry.Geometry or a *feature.Feature.
 func Length(t interface{}, units string) (float64, error) {
+	return length(t, units, lenth)
+}
+
+// Length3D measures the length of a geometry including the vertical component of each segment.
+// The altitudes of the points are expected in meters, a point without altitude is treated as being at the same
+// altitude as its neighbour.
+func Length3D(t interface{}, units string) (float64, error) {
+	return length(t, units, lenth3D)
+}
+
+func length(t interface{}, units string, lineLength func([]geometry.Point, string) (float64, error)) (float64, error) {
 
 	result := 0.0
 	var err error
 	var l float64
 	switch gtp := t.(type) {
+	case *feature.Feature:
+		return length(&gtp.Geometry, units, lineLength)
+	case *geometry.Geometry:
+		o, err := gtp.ToObject()
+		if err != nil {
+			return 0.0, err
+		}
+		return length(o, units, lineLength)
+	case *geometry.LineString:
+		return length(*gtp, units, lineLength)
+	case *geometry.MultiLineString:
+		return length(*gtp, units, lineLength)
+	case *geometry.Polygon:
+		return length(*gtp, units, lineLength)
+	case *geometry.MultiPolygon:
+		return length(*gtp, units, lineLength)
+	case *geometry.Collection:
+		return length(*gtp, units, lineLength)
+	case geometry.Collection:
+		objects, err := gtp.Objects()
+		if err != nil {
+			return 0.0, err
+		}
+		for _, o := range objects {
+			l, err = length(o, units, lineLength)
+			if err != nil {
+				return 0.0, err
+			}
+			result += l
+		}
 	case []geometry.Point:
-		l, err = lenth(gtp, units)
+		l, err = lineLength(gtp, units)
 		result = l
 	case geometry.LineString:
-		l, err = lenth(gtp.Coordinates, units)
+		l, err = lineLength(gtp.Coordinates, units)
 		result = l
 	case geometry.MultiLineString:
 		coords := gtp.Coordinates // []LineString
 		for _, c := range coords {
-			l, err = lenth(c.Coordinates, units)
+			l, err = lineLength(c.Coordinates, units)
 			if err != nil {
 				break
 			}
@@ -113,7 +157,7 @@ func Length(t interface{}, units string) (float64, error) {
 		}
 	case geometry.Polygon:
 		for _, c := range gtp.Coordinates {
-			l, err = lenth(c.Coordinates, units)
+			l, err = lineLength(c.Coordinates, units)
 			if err != nil {
 				break
 			}
@@ -123,7 +167,7 @@ func Length(t interface{}, units string) (float64, error) {
 		coords := gtp.Coordinates
 		for _, coord := range coords {
 			for _, pl := range coord.Coordinates {
-				l, err = lenth(pl.Coordinates, units)
+				l, err = lineLength(pl.Coordinates, units)
 				if err != nil {
 					break
 				}
@@ -151,17 +195,38 @@ func lenth(coords []geometry.Point, units string) (float64, error) {
 	return travelled, nil
 }
 
-// Area takes a geometry type and returns its area in square meters
+func lenth3D(coords []geometry.Point, units string) (float64, error) {
+	travelled := 0.0
+	for i := 1; i < len(coords); i++ {
+		pd, err := PointDistance(coords[i-1], coords[i], constants.UnitMeters)
+		if err != nil {
+			return 0.0, err
+		}
+		dz := 0.0
+		if coords[i-1].Alt != nil && coords[i].Alt != nil {
+			dz = *coords[i].Alt - *coords[i-1].Alt
+		}
+		travelled += math.Sqrt(pd*pd + dz*dz)
+	}
+	return conversions.ConvertLength(travelled, constants.UnitMeters, units)
+}
+
+// Area takes a geometry type and returns its area in square meters.
+// The area of a GeometryCollection is the sum of the areas of its geometries.
 func Area(t interface{}) (float64, error) {
-	switch gtp := t.(type) {
+	return area(t, ringArea)
+}
+
+func area(t interface{}, ringArea func([]geometry.Point) float64) (float64, error) {
+	switch gtp := geometry.Pointer(t).(type) {
 	case *feature.Feature:
-		return calculateArea(gtp.Geometry)
+		return calculateArea(gtp.Geometry, ringArea)
 	case *feature.Collection:
 		features := gtp.Features
 		total := 0.0
 		if len(features) > 0 {
 			for _, f := range features {
-				ar, err := calculateArea(f.Geometry)
+				ar, err := calculateArea(f.Geometry, ringArea)
 				if err != nil {
 					return 0, err
 				}
@@ -170,20 +235,30 @@ func Area(t interface{}) (float64, error) {
 		}
 		return total, nil
 	case *geometry.Geometry:
-		return calculateArea(*gtp)
+		return calculateArea(*gtp, ringArea)
 	case *geometry.Polygon:
-		return polygonArea(gtp.Coordinates), nil
+		return polygonArea(gtp.Coordinates, ringArea), nil
 	case *geometry.MultiPolygon:
 		total := 0.0
 		for i := 0; i < len(gtp.Coordinates); i++ {
-			total += polygonArea(gtp.Coordinates[i].Coordinates)
+			total += polygonArea(gtp.Coordinates[i].Coordinates, ringArea)
+		}
+		return total, nil
+	case *geometry.Collection:
+		total := 0.0
+		for _, g := range gtp.Geometries {
+			ar, err := calculateArea(g, ringArea)
+			if err != nil {
+				return 0, err
+			}
+			total += ar
 		}
 		return total, nil
 	}
 	return 0.0, nil
 }
 
-func calculateArea(g geometry.Geometry) (float64, error) {
+func calculateArea(g geometry.Geometry, ringArea func([]geometry.Point) float64) (float64, error) {
 	total := 0.0
 	if g.GeoJSONType == geojson.Polygon {
 
@@ -191,24 +266,30 @@ func calculateArea(g geometry.Geometry) (float64, error) {
 		if err != nil {
 			return 0.0, errors.New("cannot convert geometry to Polygon")
 		}
-		return polygonArea(poly.Coordinates), nil
+		return polygonArea(poly.Coordinates, ringArea), nil
 	} else if g.GeoJSONType == geojson.MultiPolygon {
 		multiPoly, err := g.ToMultiPolygon()
 		if err != nil {
 			return 0.0, errors.New("cannot convert geometry to MultiPolygon")
 		}
 		for i := 0; i < len(multiPoly.Coordinates); i++ {
-			total += polygonArea(multiPoly.Coordinates[i].Coordinates)
+			total += polygonArea(multiPoly.Coordinates[i].Coordinates, ringArea)
 		}
 
 		return total, nil
+	} else if g.GeoJSONType == geojson.GeometryCollection {
+		gc, err := g.ToGeometryCollection()
+		if err != nil {
+			return 0.0, errors.New("cannot convert geometry to GeometryCollection")
+		}
+		return area(gc, ringArea)
 	} else {
 		// area should be 0 for Point, MultiPoint, LineString and MultiLineString
 		return total, nil
 	}
 }
 
-func polygonArea(coords []geometry.LineString) float64 {
+func polygonArea(coords []geometry.LineString, ringArea func([]geometry.Point) float64) float64 {
 	total := 0.0
 	if len(coords) > 0 {
 		total += math.Abs(ringArea(coords[0].Coordinates))
@@ -228,6 +309,12 @@ func polygonArea(coords []geometry.LineString) float64 {
 // JPL Publication 07-03, Jet Propulsion
 // Laboratory, Pasadena, CA, June 2007 https://trs.jpl.nasa.gov/handle/2014/41271
 func ringArea(coords []geometry.Point) float64 {
+	return projectedRingArea(coords, constants.EarthRadius, math.Sin)
+}
+
+// projectedRingArea calculates the area of the ring in the cylindrical equal-area projection given by sinLat,
+// which maps a latitude in radians to the sine of its latitude on the sphere of the given radius.
+func projectedRingArea(coords []geometry.Point, radius float64, sinLat func(float64) float64) float64 {
 	var p1 geometry.Point
 	var p2 geometry.Point
 	var p3 geometry.Point
@@ -255,9 +342,9 @@ func ringArea(coords []geometry.Point) float64 {
 			p1 = coords[lowerIndex]
 			p2 = coords[middleIndex]
 			p3 = coords[upperIndex]
-			total += (conversions.DegreesToRadians(p3.Lng) - conversions.DegreesToRadians(p1.Lng)) * math.Sin(conversions.DegreesToRadians(p2.Lat))
+			total += (conversions.DegreesToRadians(p3.Lng) - conversions.DegreesToRadians(p1.Lng)) * sinLat(conversions.DegreesToRadians(p2.Lat))
 		}
-		total = total * constants.EarthRadius * constants.EarthRadius / 2.0
+		total = total * radius * radius / 2.0
 	}
 	return total
 }
@@ -277,8 +364,10 @@ func bboxGeom(t interface{}, excludeWrapCoord bool) ([]float64, error) {
 }
 
 // Along Takes a line and returns a point at a specified distance along the line.
+// The altitude and the measure of the point are interpolated linearly between the vertices of the segment it lies on.
 func Along(ln geometry.LineString, distance float64, units string) (*geometry.Point, error) {
 	travelled := 0.0
+	segment := 0.0
 	for i := 0; i < len(ln.Coordinates); i++ {
 		if distance >= travelled && i == len(ln.Coordinates)-1 {
 			break
@@ -293,6 +382,9 @@ func Along(ln geometry.LineString, distance float64, units string) (*geometry.Po
 			if err != nil {
 				return nil, err
 			}
+			ratio := -overshot / segment
+			d.Alt = interpolate(ln.Coordinates[i].Alt, ln.Coordinates[i-1].Alt, ratio)
+			d.M = interpolate(ln.Coordinates[i].M, ln.Coordinates[i-1].M, ratio)
 			return d, nil
 		} else {
 			pd, err := PointDistance(ln.Coordinates[i], ln.Coordinates[i+1], units)
@@ -300,12 +392,22 @@ func Along(ln geometry.LineString, distance float64, units string) (*geometry.Po
 				return nil, err
 			}
 			travelled += pd
+			segment = pd
 		}
 	}
 
 	return &ln.Coordinates[len(ln.Coordinates)-1], nil
 }
 
+// interpolate returns the value at ratio between a and b, or nil if any of them is missing.
+func interpolate(a *float64, b *float64, ratio float64) *float64 {
+	if a == nil || b == nil {
+		return nil
+	}
+	v := *a + (*b-*a)*ratio
+	return &v
+}
+
 // BBoxPolygon takes a BoundingBox and returns an equivalent polygon.
 func BBoxPolygon(bbox geojson.BBOX, id string) (*feature.Feature, error) {
 
@@ -342,7 +444,7 @@ func BBoxPolygon(bbox geojson.BBOX, id string) (*feature.Feature, error) {
 		Coordinates: cds,
 	}
 
-	f, err := feature.New(geom, bbbox, nil, id)
+	f, err := feature.New(geom, bbbox, nil, feature.StringID(id))
 	if err != nil {
 		return nil, err
 	}
@@ -400,7 +502,7 @@ func CenterFeatureCollection(fc feature.Collection, properties map[string]interf
 		GeoJSONType: geojson.Point,
 		Coordinates: coords,
 	}
-	f, err := feature.New(g, ext, properties, id)
+	f, err := feature.New(g, ext, properties, feature.StringID(id))
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package measurement
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
@@ -30,6 +31,7 @@ const BBoxMultiLineString = "../test-data/bbox-multilinestring.json"
 const BBoxMultiPolygon = "../test-data/bbox-multipolygon.json"
 const BBoxGeometryMultiPolygon = "../test-data/bbox-geometry-multipolygon.json"
 const AlongDCLine = "../test-data/along-dc-line.json"
+const GeometryCollectionFeature = "../test-data/geometry-collection.json"
 
 func TestDistance(t *testing.T) {
 	d, err := Distance(-75.343, 39.984, -75.534, 39.123, constants.UnitMiles)
@@ -118,7 +120,7 @@ func TestMidPoint(t *testing.T) {
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			m := MidPoint(tt.args.p1, tt.args.p2)
-			if tt.want != m {
+			if !reflect.DeepEqual(tt.want, m) {
 				t.Errorf("error calculating the midpoint")
 				return
 			}
@@ -897,7 +899,7 @@ func TestCenterFeature(t *testing.T) {
 		Coordinates: coords,
 	}
 
-	ef, err := feature.New(g, nil, nil, "")
+	ef, err := feature.New(g, nil, nil, feature.ID{})
 	ef.Bbox = []float64{
 		113, -39, 154, -15,
 	}
@@ -1002,3 +1004,138 @@ func TestCenterFeatureCollection(t *testing.T) {
 		assert.Equal(t, p.Lat, 47.214224817196836)
 	}
 }
+
+func TestLengthGeometry(t *testing.T) {
+	gjson, err := utils.LoadJSONFixture(LineDistanceRouteOne)
+	if err != nil {
+		t.Errorf("LoadJSONFixture error: %v", err)
+	}
+
+	f, err := feature.FromJSON(gjson)
+	if err != nil {
+		t.Errorf("FromJSON error: %v", err)
+	}
+
+	l, err := Length(f, constants.UnitDefault)
+	if err != nil {
+		t.Errorf("Length error %v", err)
+	}
+	assert.Equal(t, l, 325.737252622811)
+
+	o, err := f.ToObject()
+	if err != nil {
+		t.Errorf("ToObject error %v", err)
+	}
+	l, err = Length(o, constants.UnitDefault)
+	if err != nil {
+		t.Errorf("Length error %v", err)
+	}
+	assert.Equal(t, l, 325.737252622811)
+}
+
+func TestAlongInterpolatesAltitude(t *testing.T) {
+	ln, err := geometry.NewLineString([]geometry.Point{
+		*geometry.NewPointZ(0, 0, 100),
+		*geometry.NewPointZ(0, 1, 200),
+		*geometry.NewPointZ(0, 2, 200),
+	})
+	if err != nil {
+		t.Errorf("NewLineString error %v", err)
+	}
+	segment, err := PointDistance(ln.Coordinates[0], ln.Coordinates[1], constants.UnitDefault)
+	if err != nil {
+		t.Errorf("PointDistance error %v", err)
+	}
+
+	p, err := Along(*ln, segment/4, constants.UnitDefault)
+	if err != nil {
+		t.Errorf("Along error %v", err)
+	}
+	if p.Alt == nil {
+		t.Fatal("the altitude should be interpolated")
+	}
+	assert.Equal(t, math.Round(*p.Alt), 125.0)
+
+	p, err = Along(*ln, segment*1.5, constants.UnitDefault)
+	if err != nil {
+		t.Errorf("Along error %v", err)
+	}
+	assert.Equal(t, *p.Alt, 200.0)
+}
+
+func TestLength3D(t *testing.T) {
+	ln, err := geometry.NewLineString([]geometry.Point{
+		*geometry.NewPointZ(0, 0, 0),
+		*geometry.NewPointZ(0, 0, 300),
+		*geometry.NewPointZ(0.001, 0, 300),
+	})
+	if err != nil {
+		t.Errorf("NewLineString error %v", err)
+	}
+
+	l2d, err := Length(*ln, constants.UnitMeters)
+	if err != nil {
+		t.Errorf("Length error %v", err)
+	}
+	l3d, err := Length3D(*ln, constants.UnitMeters)
+	if err != nil {
+		t.Errorf("Length3D error %v", err)
+	}
+	assert.Equal(t, math.Round(l3d-l2d), 300.0)
+
+	km, err := Length3D(ln, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Length3D error %v", err)
+	}
+	assert.Equal(t, math.Round(km*1000), math.Round(l3d))
+}
+
+func TestGeometryCollectionAreaAndLength(t *testing.T) {
+	gjson, err := utils.LoadJSONFixture(GeometryCollectionFeature)
+	if err != nil {
+		t.Errorf("LoadJSONFixture error: %v", err)
+	}
+
+	f, err := feature.FromJSON(gjson)
+	if err != nil {
+		t.Errorf("FromJSON error: %v", err)
+	}
+
+	polygon, err := f.Geometry.Geometries[2].Geometries[0].ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error: %v", err)
+	}
+	polygonArea, err := Area(polygon)
+	if err != nil {
+		t.Errorf("Area error: %v", err)
+	}
+
+	area, err := Area(f)
+	if err != nil {
+		t.Errorf("Area error: %v", err)
+	}
+	assert.Equal(t, area, polygonArea)
+
+	polygonLength, err := Length(polygon, constants.UnitDefault)
+	if err != nil {
+		t.Errorf("Length error: %v", err)
+	}
+	lineLength, err := Distance(101, 0, 102, 1, constants.UnitDefault)
+	if err != nil {
+		t.Errorf("Distance error: %v", err)
+	}
+
+	l, err := Length(f, constants.UnitDefault)
+	if err != nil {
+		t.Errorf("Length error: %v", err)
+	}
+	assert.Equal(t, l, lineLength+polygonLength)
+
+	bbox, err := BBox(f)
+	if err != nil {
+		t.Errorf("BBox error: %v", err)
+	}
+	if !reflect.DeepEqual(bbox, []float64{100, -27, 154, 1}) {
+		t.Errorf("BBox = %v", bbox)
+	}
+}
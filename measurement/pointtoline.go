@@ -0,0 +1,187 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/conversions"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/meta/coordAll"
+)
+
+const (
+	// MethodGeodesic measures along great circles on the sphere.
+	MethodGeodesic = "geodesic"
+	// MethodPlanar treats the coordinates as planar and measures along rhumb lines.
+	MethodPlanar = "planar"
+)
+
+// PointToLineDistance returns the minimum distance between a point and a line, measured with the given method.
+// The method is MethodGeodesic if empty.
+// line can be a *geometry.LineString, a *geometry.MultiLineString, a *geometry.Geometry or a *feature.Feature of them.
+func PointToLineDistance(point geometry.Point, line interface{}, units string, method string) (float64, error) {
+	if method == "" {
+		method = MethodGeodesic
+	}
+	if method != MethodGeodesic && method != MethodPlanar {
+		return 0.0, errors.New("invalid method")
+	}
+	lines, err := meta.LineStrings(line)
+	if err != nil {
+		return 0.0, err
+	}
+
+	minDist := math.Inf(1)
+	for _, ln := range lines {
+		for i := 1; i < len(ln.Coordinates); i++ {
+			nearest := nearestOnSegment(point, ln.Coordinates[i-1], ln.Coordinates[i], method)
+			d, err := methodDistance(point, nearest, units, method)
+			if err != nil {
+				return 0.0, err
+			}
+			minDist = math.Min(minDist, d)
+		}
+	}
+	if math.IsInf(minDist, 1) {
+		return 0.0, errors.New("the line has no segments")
+	}
+	return minDist, nil
+}
+
+// NearestPointOnLine takes a line and a point and returns the point of the line closest to it.
+// The point is returned as a feature with the index property holding the index of the segment it lies on within its
+// line, the multiFeatureIndex property holding the index of the line in a MultiLineString (0 for a LineString),
+// the dist property holding its distance from the given point and the location property holding its distance
+// along the line from the start, both in the given units. The lines of a MultiLineString are measured as one.
+// line can be a *geometry.LineString, a *geometry.MultiLineString, a *geometry.Geometry or a *feature.Feature of them.
+func NearestPointOnLine(line interface{}, point geometry.Point, units string) (*feature.Feature, error) {
+	lines, err := meta.LineStrings(line)
+	if err != nil {
+		return nil, err
+	}
+
+	var nearest geometry.Point
+	index := -1
+	multiFeatureIndex := -1
+	minDist := math.Inf(1)
+	location := 0.0
+	travelled := 0.0
+	for j, ln := range lines {
+		for i := 1; i < len(ln.Coordinates); i++ {
+			start := ln.Coordinates[i-1]
+			stop := ln.Coordinates[i]
+			p := nearestOnSegment(point, start, stop, MethodGeodesic)
+			d, err := PointDistance(point, p, units)
+			if err != nil {
+				return nil, err
+			}
+			along, err := PointDistance(start, p, units)
+			if err != nil {
+				return nil, err
+			}
+			segment, err := PointDistance(start, stop, units)
+			if err != nil {
+				return nil, err
+			}
+			if d < minDist {
+				if segment > 0 {
+					p.Alt = interpolate(start.Alt, stop.Alt, along/segment)
+					p.M = interpolate(start.M, stop.M, along/segment)
+				}
+				nearest = p
+				index = i - 1
+				multiFeatureIndex = j
+				minDist = d
+				location = travelled + along
+			}
+			travelled += segment
+		}
+	}
+	if index < 0 {
+		return nil, errors.New("the line has no segments")
+	}
+
+	g, err := geometry.NewGeometry(nearest)
+	if err != nil {
+		return nil, err
+	}
+	properties := map[string]interface{}{
+		"index":             index,
+		"multiFeatureIndex": multiFeatureIndex,
+		"dist":              minDist,
+		"location":          location,
+	}
+	return feature.New(*g, nil, properties, feature.ID{})
+}
+
+// methodDistance returns the distance between the points measured with the given method.
+func methodDistance(p1 geometry.Point, p2 geometry.Point, units string, method string) (float64, error) {
+	if method == MethodPlanar {
+		return RhumbDistance(p1, p2, units)
+	}
+	return PointDistance(p1, p2, units)
+}
+
+// nearestOnSegment returns the point of the segment from a to b closest to p.
+// The planar method projects the point on the segment in the plane of the coordinates, the geodesic method
+// projects it on the great circle arc of the segment.
+func nearestOnSegment(p geometry.Point, a geometry.Point, b geometry.Point, method string) geometry.Point {
+	if method == MethodPlanar {
+		vx, vy := b.Lng-a.Lng, b.Lat-a.Lat
+		c1 := (p.Lng-a.Lng)*vx + (p.Lat-a.Lat)*vy
+		if c1 <= 0 {
+			return geometry.Point{Lng: a.Lng, Lat: a.Lat}
+		}
+		c2 := vx*vx + vy*vy
+		if c2 <= c1 {
+			return geometry.Point{Lng: b.Lng, Lat: b.Lat}
+		}
+		return geometry.Point{Lng: a.Lng + c1/c2*vx, Lat: a.Lat + c1/c2*vy}
+	}
+
+	va, vb, vp := toVector(a), toVector(b), toVector(p)
+	n := cross(va, vb)
+	if dot(n, n) == 0 {
+		// degenerate segment
+		return geometry.Point{Lng: a.Lng, Lat: a.Lat}
+	}
+	// projection of the point on the plane of the great circle
+	k := dot(vp, n) / dot(n, n)
+	c := [3]float64{vp[0] - k*n[0], vp[1] - k*n[1], vp[2] - k*n[2]}
+	if dot(c, c) != 0 && dot(cross(va, c), n) >= 0 && dot(cross(c, vb), n) >= 0 {
+		return fromVector(c)
+	}
+
+	// the projection is outside the arc, so the closest point is one of its ends
+	da, _ := PointDistance(p, a, constants.UnitRadians)
+	db, _ := PointDistance(p, b, constants.UnitRadians)
+	if db < da {
+		return geometry.Point{Lng: b.Lng, Lat: b.Lat}
+	}
+	return geometry.Point{Lng: a.Lng, Lat: a.Lat}
+}
+
+// toVector returns the unit vector of the point on the sphere.
+func toVector(p geometry.Point) [3]float64 {
+	lambda := conversions.DegreesToRadians(p.Lng)
+	phi := conversions.DegreesToRadians(p.Lat)
+	return [3]float64{math.Cos(phi) * math.Cos(lambda), math.Cos(phi) * math.Sin(lambda), math.Sin(phi)}
+}
+
+// fromVector returns the point of the direction of the vector on the sphere.
+func fromVector(v [3]float64) geometry.Point {
+	return geometry.Point{
+		Lng: conversions.RadiansToDegrees(math.Atan2(v[1], v[0])),
+		Lat: conversions.RadiansToDegrees(math.Atan2(v[2], math.Hypot(v[0], v[1]))),
+	}
+}
+
+func cross(a [3]float64, b [3]float64) [3]float64 {
+	return [3]float64{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
+
+func dot(a [3]float64, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
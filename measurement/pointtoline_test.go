@@ -0,0 +1,122 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+func TestPointToLineDistance(t *testing.T) {
+	equator := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 10, Lat: 0}}}
+	tests := map[string]struct {
+		point  geometry.Point
+		method string
+		want   float64
+	}{
+		"geodesic": {
+			point:  geometry.Point{Lng: 5, Lat: 1},
+			method: MethodGeodesic,
+			want:   math.Pi / 180,
+		},
+		"planar": {
+			point:  geometry.Point{Lng: 5, Lat: -1},
+			method: MethodPlanar,
+			want:   math.Pi / 180,
+		},
+		"default method": {
+			point: geometry.Point{Lng: 5, Lat: 1},
+			want:  math.Pi / 180,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := PointToLineDistance(tt.point, &equator, constants.UnitRadians, tt.method)
+			if err != nil {
+				t.Errorf("PointToLineDistance error %v", err)
+			}
+			if math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("PointToLineDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// beyond the end of the line the distance is measured to the end
+	got, err := PointToLineDistance(geometry.Point{Lng: 13, Lat: 4}, &equator, constants.UnitRadians, MethodGeodesic)
+	if err != nil {
+		t.Errorf("PointToLineDistance error %v", err)
+	}
+	want, err := PointDistance(geometry.Point{Lng: 13, Lat: 4}, geometry.Point{Lng: 10, Lat: 0}, constants.UnitRadians)
+	if err != nil {
+		t.Errorf("PointDistance error %v", err)
+	}
+	assert.Equal(t, got, want)
+
+	_, err = PointToLineDistance(geometry.Point{}, &equator, constants.UnitRadians, "manhattan")
+	if err == nil {
+		t.Errorf("PointToLineDistance expected an error for invalid method")
+	}
+	_, err = PointToLineDistance(geometry.Point{}, &geometry.Point{}, constants.UnitRadians, MethodGeodesic)
+	if err == nil {
+		t.Errorf("PointToLineDistance expected an error for a point")
+	}
+}
+
+func TestNearestPointOnLine(t *testing.T) {
+	alt0 := 0.0
+	alt10 := 100.0
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0, Alt: &alt0}, {Lng: 10, Lat: 0, Alt: &alt10}, {Lng: 10, Lat: 10}}}
+	f, err := NearestPointOnLine(&ln, geometry.Point{Lng: 5, Lat: -1}, constants.UnitRadians)
+	if err != nil {
+		t.Errorf("NearestPointOnLine error %v", err)
+	}
+	p, err := f.ToPoint()
+	if err != nil {
+		t.Errorf("ToPoint error %v", err)
+	}
+	if math.Abs(p.Lng-5) > 1e-12 || math.Abs(p.Lat) > 1e-12 {
+		t.Errorf("NearestPointOnLine() = %v, want [5, 0]", p)
+	}
+	if p.Alt == nil || math.Abs(*p.Alt-50) > 1e-9 {
+		t.Errorf("NearestPointOnLine() altitude = %v, want 50", p.Alt)
+	}
+	assert.Equal(t, f.Properties["index"], 0)
+	assert.Equal(t, f.Properties["multiFeatureIndex"], 0)
+	if math.Abs(f.Properties["dist"].(float64)-math.Pi/180) > 1e-12 {
+		t.Errorf("dist = %v", f.Properties["dist"])
+	}
+	if math.Abs(f.Properties["location"].(float64)-5*math.Pi/180) > 1e-12 {
+		t.Errorf("location = %v", f.Properties["location"])
+	}
+
+	mln := geometry.MultiLineString{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 10, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 20, Lat: 0}, {Lng: 30, Lat: 0}}},
+	}}
+	f, err = NearestPointOnLine(&mln, geometry.Point{Lng: 25, Lat: 1}, constants.UnitRadians)
+	if err != nil {
+		t.Errorf("NearestPointOnLine error %v", err)
+	}
+	// the first segment of the second line
+	assert.Equal(t, f.Properties["index"], 0)
+	assert.Equal(t, f.Properties["multiFeatureIndex"], 1)
+	if math.Abs(f.Properties["location"].(float64)-15*math.Pi/180) > 1e-12 {
+		t.Errorf("location = %v", f.Properties["location"])
+	}
+
+	// the end of the line is the closest point
+	f, err = NearestPointOnLine(&ln, geometry.Point{Lng: 12, Lat: 12}, constants.UnitRadians)
+	if err != nil {
+		t.Errorf("NearestPointOnLine error %v", err)
+	}
+	p, err = f.ToPoint()
+	if err != nil {
+		t.Errorf("ToPoint error %v", err)
+	}
+	assert.Equal(t, p.Lng, 10.0)
+	assert.Equal(t, p.Lat, 10.0)
+	assert.Equal(t, f.Properties["index"], 1)
+	assert.Equal(t, f.Properties["multiFeatureIndex"], 0)
+}
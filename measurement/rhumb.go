@@ -0,0 +1,143 @@
+package measurement
+
+import (
+	"math"
+
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/conversions"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// RhumbDistance calculates the distance along a rhumb line between two points.
+// A rhumb line, or loxodrome, is a line crossing all meridians at the same angle.
+// The shortest rhumb line is taken for points on opposite sides of the antimeridian.
+// https://en.wikipedia.org/wiki/Rhumb_line
+func RhumbDistance(from geometry.Point, to geometry.Point, units string) (float64, error) {
+	// compensate the crossing of the 180th meridian
+	destination := to
+	destination.Lng += antimeridianOffset(from.Lng, to.Lng)
+
+	return conversions.ConvertLength(rhumbDistance(from, destination, constants.EarthRadius), constants.UnitMeters, units)
+}
+
+// RhumbBearing takes two points and finds the bearing angle between them along a rhumb line,
+// in decimal degrees between -180 and 180 clockwise from the north.
+func RhumbBearing(start geometry.Point, end geometry.Point) float64 {
+	bear360 := rhumbBearing(start, end)
+	if bear360 > 180 {
+		return -(360 - bear360)
+	}
+	return bear360
+}
+
+// RhumbDestination returns the destination point having travelled the given distance along a rhumb line from
+// the origin point with the given bearing in degrees from the north.
+// The longitude of the destination is continued past the antimeridian when it is crossed, so that the line from
+// the origin to the destination doesn't wrap around the world.
+func RhumbDestination(origin geometry.Point, distance float64, bearing float64, units string) (*geometry.Point, error) {
+	meters, err := conversions.ConvertLength(math.Abs(distance), units, constants.UnitMeters)
+	if err != nil {
+		return nil, err
+	}
+	if distance < 0 {
+		meters = -meters
+	}
+
+	destination := rhumbDestination(origin, meters, bearing, constants.EarthRadius)
+	// compensate the crossing of the 180th meridian
+	destination.Lng += antimeridianOffset(origin.Lng, destination.Lng)
+	return &destination, nil
+}
+
+// antimeridianOffset returns the offset which brings the second longitude to the side of the antimeridian of the first.
+func antimeridianOffset(lng1 float64, lng2 float64) float64 {
+	if lng2-lng1 > 180 {
+		return -360
+	}
+	if lng1-lng2 > 180 {
+		return 360
+	}
+	return 0
+}
+
+// rhumbDistance returns the distance between the points along a rhumb line in the units of the radius.
+// https://www.movable-type.co.uk/scripts/latlong.html#rhumblines
+func rhumbDistance(origin geometry.Point, destination geometry.Point, radius float64) float64 {
+	phi1 := conversions.DegreesToRadians(origin.Lat)
+	phi2 := conversions.DegreesToRadians(destination.Lat)
+	deltaPhi := phi2 - phi1
+	deltaLambda := conversions.DegreesToRadians(math.Abs(destination.Lng - origin.Lng))
+	// if dLon over 180° take shorter rhumb line across the anti-meridian
+	if deltaLambda > math.Pi {
+		deltaLambda -= 2 * math.Pi
+	}
+
+	// on Mercator projection, longitude distances shrink by latitude; q is the 'stretch factor'
+	// q becomes ill-conditioned along E-W line (0/0); use empirical tolerance to avoid it
+	deltaPsi := math.Log(math.Tan(phi2/2+math.Pi/4) / math.Tan(phi1/2+math.Pi/4))
+	q := math.Cos(phi1)
+	if math.Abs(deltaPsi) > 10e-12 {
+		q = deltaPhi / deltaPsi
+	}
+
+	// distance is pythagoras on 'stretched' Mercator projection
+	delta := math.Sqrt(deltaPhi*deltaPhi + q*q*deltaLambda*deltaLambda)
+	return delta * radius
+}
+
+// rhumbBearing returns the bearing of the rhumb line from the first point to the second between 0 and 360 degrees.
+func rhumbBearing(from geometry.Point, to geometry.Point) float64 {
+	phi1 := conversions.DegreesToRadians(from.Lat)
+	phi2 := conversions.DegreesToRadians(to.Lat)
+	deltaLambda := conversions.DegreesToRadians(to.Lng - from.Lng)
+	// if deltaLambda over 180° take shorter rhumb line across the anti-meridian
+	if deltaLambda > math.Pi {
+		deltaLambda -= 2 * math.Pi
+	}
+	if deltaLambda < -math.Pi {
+		deltaLambda += 2 * math.Pi
+	}
+
+	deltaPsi := math.Log(math.Tan(phi2/2+math.Pi/4) / math.Tan(phi1/2+math.Pi/4))
+	theta := math.Atan2(deltaLambda, deltaPsi)
+	return math.Mod(conversions.RadiansToDegrees(theta)+360, 360)
+}
+
+// rhumbDestination returns the destination point having travelled the distance, in the units of the radius,
+// along a rhumb line with the given bearing. The longitude is normalised to -180..180.
+func rhumbDestination(origin geometry.Point, distance float64, bearing float64, radius float64) geometry.Point {
+	// angular distance in radians
+	delta := distance / radius
+	// to radians, but without normalize to 𝜋
+	lambda1 := conversions.DegreesToRadians(origin.Lng)
+	phi1 := conversions.DegreesToRadians(origin.Lat)
+	theta := conversions.DegreesToRadians(bearing)
+
+	deltaPhi := delta * math.Cos(theta)
+	phi2 := phi1 + deltaPhi
+
+	// check for going past the pole, normalise latitude if so
+	if math.Abs(phi2) > math.Pi/2 {
+		if phi2 > 0 {
+			phi2 = math.Pi - phi2
+		} else {
+			phi2 = -math.Pi - phi2
+		}
+	}
+
+	deltaPsi := math.Log(math.Tan(phi2/2+math.Pi/4) / math.Tan(phi1/2+math.Pi/4))
+	// E-W course becomes ill-conditioned with 0/0
+	q := math.Cos(phi1)
+	if math.Abs(deltaPsi) > 10e-12 {
+		q = deltaPhi / deltaPsi
+	}
+
+	deltaLambda := delta * math.Sin(theta) / q
+	lambda2 := lambda1 + deltaLambda
+
+	return geometry.Point{
+		// normalise to −180..+180°
+		Lng: math.Mod(conversions.RadiansToDegrees(lambda2)+540, 360) - 180,
+		Lat: conversions.RadiansToDegrees(phi2),
+	}
+}
@@ -0,0 +1,162 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+func TestRhumbBearing(t *testing.T) {
+	tests := map[string]struct {
+		start geometry.Point
+		end   geometry.Point
+		want  float64
+	}{
+		"north east": {
+			start: geometry.Point{Lng: -75, Lat: 45},
+			end:   geometry.Point{Lng: 20, Lat: 60},
+			want:  75.28061364784332,
+		},
+		"south west": {
+			start: geometry.Point{Lng: 20, Lat: 60},
+			end:   geometry.Point{Lng: -75, Lat: 45},
+			want:  -104.71938635215668,
+		},
+		"across the antimeridian": {
+			start: geometry.Point{Lng: 179.5, Lat: 0},
+			end:   geometry.Point{Lng: -179.5, Lat: 0},
+			want:  90,
+		},
+		"north": {
+			start: geometry.Point{Lng: 10, Lat: 0},
+			end:   geometry.Point{Lng: 10, Lat: 10},
+			want:  0,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := RhumbBearing(tt.start, tt.end)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("RhumbBearing() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRhumbDistance(t *testing.T) {
+	tests := map[string]struct {
+		from  geometry.Point
+		to    geometry.Point
+		units string
+		want  float64
+	}{
+		"miles": {
+			from:  geometry.Point{Lng: -75.343, Lat: 39.984},
+			to:    geometry.Point{Lng: -75.534, Lat: 39.123},
+			units: constants.UnitMiles,
+			want:  60.35331130430886,
+		},
+		"kilometres": {
+			from:  geometry.Point{Lng: -75.343, Lat: 39.984},
+			to:    geometry.Point{Lng: -75.534, Lat: 39.123},
+			units: constants.UnitDefault,
+			want:  97.12923942772163,
+		},
+		"across the antimeridian": {
+			from:  geometry.Point{Lng: 179.5, Lat: 0},
+			to:    geometry.Point{Lng: -179.5, Lat: 0},
+			units: constants.UnitRadians,
+			want:  math.Pi / 180,
+		},
+		"along a meridian": {
+			from:  geometry.Point{Lng: 10, Lat: -10},
+			to:    geometry.Point{Lng: 10, Lat: 10},
+			units: constants.UnitRadians,
+			want:  20 * math.Pi / 180,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := RhumbDistance(tt.from, tt.to, tt.units)
+			if err != nil {
+				t.Errorf("RhumbDistance error %v", err)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("RhumbDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	_, err := RhumbDistance(geometry.Point{}, geometry.Point{Lng: 1}, "furlongs")
+	if err == nil {
+		t.Errorf("RhumbDistance expected an error for invalid units")
+	}
+}
+
+func TestRhumbDestination(t *testing.T) {
+	tests := map[string]struct {
+		origin   geometry.Point
+		distance float64
+		bearing  float64
+		units    string
+		want     geometry.Point
+	}{
+		"east along the equator": {
+			origin:   geometry.Point{Lng: 10, Lat: 0},
+			distance: math.Pi / 180,
+			bearing:  90,
+			units:    constants.UnitRadians,
+			want:     geometry.Point{Lng: 11, Lat: 0},
+		},
+		"negative distance": {
+			origin:   geometry.Point{Lng: 10, Lat: 0},
+			distance: -math.Pi / 180,
+			bearing:  90,
+			units:    constants.UnitRadians,
+			want:     geometry.Point{Lng: 9, Lat: 0},
+		},
+		"across the antimeridian": {
+			origin:   geometry.Point{Lng: 179.5, Lat: 0},
+			distance: math.Pi / 180,
+			bearing:  90,
+			units:    constants.UnitRadians,
+			want:     geometry.Point{Lng: 180.5, Lat: 0},
+		},
+		"north": {
+			origin:   geometry.Point{Lng: 10, Lat: 10},
+			distance: 10 * math.Pi / 180,
+			bearing:  0,
+			units:    constants.UnitRadians,
+			want:     geometry.Point{Lng: 10, Lat: 20},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := RhumbDestination(tt.origin, tt.distance, tt.bearing, tt.units)
+			if err != nil {
+				t.Errorf("RhumbDestination error %v", err)
+			}
+			if math.Abs(got.Lng-tt.want.Lng) > 1e-9 || math.Abs(got.Lat-tt.want.Lat) > 1e-9 {
+				t.Errorf("RhumbDestination() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRhumbRoundTrip(t *testing.T) {
+	from := geometry.Point{Lng: -75, Lat: 45}
+	to := geometry.Point{Lng: 20, Lat: 60}
+	d, err := RhumbDistance(from, to, constants.UnitNauticalMiles)
+	if err != nil {
+		t.Errorf("RhumbDistance error %v", err)
+	}
+	dest, err := RhumbDestination(from, d, RhumbBearing(from, to), constants.UnitNauticalMiles)
+	if err != nil {
+		t.Errorf("RhumbDestination error %v", err)
+	}
+	assert.Equal(t, math.Abs(dest.Lng-to.Lng) < 1e-9, true)
+	assert.Equal(t, math.Abs(dest.Lat-to.Lat) < 1e-9, true)
+}
@@ -9,8 +9,15 @@ import (
 )
 
 // CoordAll get all coordinates from any GeoJSON object.
+// t can be any typed geometry.Object, a *geometry.Geometry, a *feature.Feature or a *feature.Collection.
 func CoordAll(t interface{}, excludeWrapCoord *bool) ([]geometry.Point, error) {
-	switch gtp := t.(type) {
+	switch gtp := geometry.Pointer(t).(type) {
+	case *geometry.Geometry:
+		o, err := gtp.ToObject()
+		if err != nil {
+			return nil, err
+		}
+		return CoordAll(o, excludeWrapCoord)
 	case *geometry.Point:
 		return coordAllPoint(*gtp), nil
 	case *geometry.MultiPoint:
@@ -30,6 +37,9 @@ func CoordAll(t interface{}, excludeWrapCoord *bool) ([]geometry.Point, error) {
 		}
 		return coordAllMultiPolygon(*gtp, *excludeWrapCoord), nil
 	case *feature.Feature:
+		if excludeWrapCoord == nil {
+			return nil, errors.New("exclude wrap coord can't be null")
+		}
 		return coordAllFeature(*gtp, *excludeWrapCoord)
 	case *feature.Collection:
 		if excludeWrapCoord == nil {
@@ -37,12 +47,10 @@ func CoordAll(t interface{}, excludeWrapCoord *bool) ([]geometry.Point, error) {
 		}
 		return coordAllFeatureCollection(*gtp, *excludeWrapCoord)
 	case *geometry.Collection:
-		pts := []geometry.Point{}
-		for _, gmt := range gtp.Geometries {
-			snl, _ := coordsAllFromSingleGeometry(pts, gmt, *excludeWrapCoord)
-			pts = append(pts, snl...)
+		if excludeWrapCoord == nil {
+			return nil, errors.New("exclude wrap coord can't be null")
 		}
-		return pts, nil
+		return appendCoordsToGeometryCollection([]geometry.Point{}, *gtp, *excludeWrapCoord)
 	}
 
 	return nil, nil
@@ -143,56 +151,46 @@ func appendCoordToFeature(pointList []geometry.Point, f feature.Feature, exclude
 }
 
 func coordsAllFromSingleGeometry(pointList []geometry.Point, g geometry.Geometry, excludeWrapCoord bool) ([]geometry.Point, error) {
-
-	if g.GeoJSONType == geojson.Point {
-		p, err := g.ToPoint()
-		if err != nil {
-			return nil, err
-		}
-		pointList = append(pointList, *p)
-	}
-
-	if g.GeoJSONType == geojson.MultiPoint {
-		mp, err := g.ToMultiPoint()
-		if err != nil {
-			return nil, err
-		}
-		pointList = appendCoordsToMultiPoint(pointList, *mp)
-	}
-
-	if g.GeoJSONType == geojson.LineString {
-		ln, err := g.ToLineString()
-		if err != nil {
-			return nil, err
-		}
-		pointList = appendCoordsToLineString(pointList, *ln)
+	// an unlocated feature has no coordinates
+	if g.GeoJSONType == "" {
+		return pointList, nil
 	}
 
-	if g.GeoJSONType == geojson.MiltiLineString {
-		mln, err := g.ToMultiLineString()
-		if err != nil {
-			return nil, err
-		}
-		pointList = appendCoordToMultiLineString(pointList, *mln)
+	o, err := g.ToObject()
+	if err != nil {
+		return nil, err
 	}
+	return appendCoordsToObject(pointList, o, excludeWrapCoord)
+}
 
-	if g.GeoJSONType == geojson.Polygon {
-		poly, err := g.ToPolygon()
+func appendCoordsToGeometryCollection(pointList []geometry.Point, gc geometry.Collection, excludeWrapCoord bool) ([]geometry.Point, error) {
+	var err error
+	for _, g := range gc.Geometries {
+		pointList, err = coordsAllFromSingleGeometry(pointList, g, excludeWrapCoord)
 		if err != nil {
 			return nil, err
 		}
-		return appendCoordsToPolygon(pointList, *poly, excludeWrapCoord), nil
-
 	}
+	return pointList, nil
+}
 
-	if g.GeoJSONType == geojson.MultiPolygon {
-		multiPoly, err := g.ToMultiPolygon()
-		if err != nil {
-			return nil, err
-		}
-		return appendCoordToMultiPolygon(pointList, *multiPoly, excludeWrapCoord), nil
+func appendCoordsToObject(pointList []geometry.Point, o geometry.Object, excludeWrapCoord bool) ([]geometry.Point, error) {
+	switch gtp := o.(type) {
+	case *geometry.Point:
+		pointList = append(pointList, *gtp)
+	case *geometry.MultiPoint:
+		pointList = appendCoordsToMultiPoint(pointList, *gtp)
+	case *geometry.LineString:
+		pointList = appendCoordsToLineString(pointList, *gtp)
+	case *geometry.MultiLineString:
+		pointList = appendCoordToMultiLineString(pointList, *gtp)
+	case *geometry.Polygon:
+		pointList = appendCoordsToPolygon(pointList, *gtp, excludeWrapCoord)
+	case *geometry.MultiPolygon:
+		pointList = appendCoordToMultiPolygon(pointList, *gtp, excludeWrapCoord)
+	case *geometry.Collection:
+		return appendCoordsToGeometryCollection(pointList, *gtp, excludeWrapCoord)
 	}
-
 	return pointList, nil
 }
 
@@ -1,6 +1,8 @@
 package meta
 
 import (
+	"reflect"
+
 	"github.com/tomchavakis/turf-go/assert"
 	"github.com/tomchavakis/turf-go/geojson/feature"
 	"github.com/tomchavakis/turf-go/geojson/geometry"
@@ -187,7 +189,92 @@ func TestCoordAllFeatureCollection(t *testing.T) {
 	assert.Equal(t, pts[0].Lng, -112.0372)
 }
 
-// TODO: Write Test after BBOX
+func TestCoordAllGeometry(t *testing.T) {
+	g, err := geometry.FromJSON("{ \"type\": \"MultiLineString\", \"coordinates\": [[[0.0, 0.0], [1.0, 1.0]], [[2.0, 2.0], [3.0, 3.0]]]}")
+	if err != nil {
+		t.Errorf("geometry error %v", err)
+	}
+
+	pts, err := CoordAll(g, nil)
+	if err != nil {
+		t.Errorf("CoordAll err %v", err)
+	}
+	assert.Equal(t, len(pts), 4)
+	assert.Equal(t, pts[3].Lat, 3.0)
+	assert.Equal(t, pts[3].Lng, 3.0)
+}
+
+func TestCoordAllPointZ(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0.0, 0.0, 100.0], [1.0, 1.0, 150.0]]}}")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+	}
+
+	e := false
+	pts, err := CoordAll(f, &e)
+	if err != nil {
+		t.Errorf("CoordAll err %v", err)
+	}
+	assert.Equal(t, len(pts), 2)
+	assert.Equal(t, *pts[0].Alt, 100.0)
+	assert.Equal(t, *pts[1].Alt, 150.0)
+}
+
 func TestCoordAllGeometryCollection(t *testing.T) {
+	json := "{ \"type\": \"GeometryCollection\", \"geometries\": [{ \"type\": \"Point\", \"coordinates\": [0.0, 0.0]}, { \"type\": \"GeometryCollection\", \"geometries\": [{ \"type\": \"LineString\", \"coordinates\": [[1.0, 1.0], [2.0, 2.0]]}]}]}"
+	g, err := geometry.FromJSON(json)
+	if err != nil {
+		t.Errorf("geometry error %v", err)
+	}
+
+	gc, err := g.ToGeometryCollection()
+	if err != nil {
+		t.Errorf("convert to GeometryCollection error %v", err)
+	}
+
+	e := false
+	pts, err := CoordAll(gc, &e)
+	if err != nil {
+		t.Errorf("CoordAll err %v", err)
+	}
+	assert.Equal(t, len(pts), 3)
+	assert.Equal(t, pts[0].Lng, 0.0)
+	assert.Equal(t, pts[1].Lng, 1.0)
+	assert.Equal(t, pts[2].Lng, 2.0)
+
+	pts, err = CoordAll(g, &e)
+	if err != nil {
+		t.Errorf("CoordAll err %v", err)
+	}
+	assert.Equal(t, len(pts), 3)
+}
 
+func TestCoordAllValueTypes(t *testing.T) {
+	excludeWrapCoord := true
+	poly := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}}},
+	}}
+	tests := map[string]struct {
+		value   interface{}
+		pointer interface{}
+	}{
+		"point":        {value: geometry.Point{Lng: 1, Lat: 2}, pointer: &geometry.Point{Lng: 1, Lat: 2}},
+		"polygon":      {value: poly, pointer: &poly},
+		"multiPolygon": {value: geometry.MultiPolygon{Coordinates: []geometry.Polygon{poly}}, pointer: &geometry.MultiPolygon{Coordinates: []geometry.Polygon{poly}}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			want, err := CoordAll(tt.pointer, &excludeWrapCoord)
+			if err != nil {
+				t.Fatalf("CoordAll err %v", err)
+			}
+			got, err := CoordAll(tt.value, &excludeWrapCoord)
+			if err != nil {
+				t.Fatalf("CoordAll err %v", err)
+			}
+			if len(got) == 0 || !reflect.DeepEqual(got, want) {
+				t.Errorf("CoordAll() = %v, want %v", got, want)
+			}
+		})
+	}
 }
@@ -0,0 +1,28 @@
+package meta
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// LineStrings returns the lines of a LineString or a MultiLineString.
+// t can be a geometry.LineString, a geometry.MultiLineString, a *geometry.Geometry or a *feature.Feature of them.
+func LineStrings(t interface{}) ([]geometry.LineString, error) {
+	switch gtp := geometry.Pointer(t).(type) {
+	case *feature.Feature:
+		return LineStrings(&gtp.Geometry)
+	case *geometry.Geometry:
+		o, err := gtp.ToObject()
+		if err != nil {
+			return nil, err
+		}
+		return LineStrings(o)
+	case *geometry.LineString:
+		return []geometry.LineString{*gtp}, nil
+	case *geometry.MultiLineString:
+		return gtp.Coordinates, nil
+	}
+	return nil, errors.New("geometry must be a LineString or a MultiLineString")
+}
@@ -0,0 +1,46 @@
+package meta
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+func TestLineStrings(t *testing.T) {
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}}}
+	other := geometry.LineString{Coordinates: []geometry.Point{{Lng: 2, Lat: 2}, {Lng: 3, Lat: 3}}}
+	mln := geometry.MultiLineString{Coordinates: []geometry.LineString{ln, other}}
+	g, err := geometry.NewGeometry(&mln)
+	if err != nil {
+		t.Fatalf("NewGeometry error %v", err)
+	}
+
+	tests := map[string]struct {
+		geometry interface{}
+		want     []geometry.LineString
+	}{
+		"line":          {geometry: &ln, want: []geometry.LineString{ln}},
+		"line by value": {geometry: ln, want: []geometry.LineString{ln}},
+		"multi line":    {geometry: &mln, want: []geometry.LineString{ln, other}},
+		"geometry":      {geometry: g, want: []geometry.LineString{ln, other}},
+		"feature":       {geometry: &feature.Feature{Geometry: *g}, want: []geometry.LineString{ln, other}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			lines, err := LineStrings(tt.geometry)
+			if err != nil {
+				t.Fatalf("LineStrings error %v", err)
+			}
+			if !reflect.DeepEqual(lines, tt.want) {
+				t.Errorf("LineStrings() = %v, want %v", lines, tt.want)
+			}
+		})
+	}
+
+	_, err = LineStrings(&geometry.Point{Lng: 0, Lat: 0})
+	if err == nil {
+		t.Errorf("LineStrings expected an error for a Point")
+	}
+}
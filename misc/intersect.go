@@ -0,0 +1,160 @@
+package misc
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/index"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// segment is a segment of a line or of a ring of a polygon.
+type segment struct {
+	a geometry.Point
+	b geometry.Point
+}
+
+// LineIntersect takes two geometries made of lines or polygons and returns the points where they intersect.
+// Segments of the geometries which are parallel don't intersect, even if they overlap.
+// The segments of the second geometry are indexed, so that only the segments with intersecting bounding boxes are
+// compared.
+// t1 and t2 can be any typed geometry.Object, a *geometry.Geometry, a *feature.Feature or a *feature.Collection of
+// lines and polygons.
+func LineIntersect(t1 interface{}, t2 interface{}) (*feature.Collection, error) {
+	s1, err := segmentsOf(t1)
+	if err != nil {
+		return nil, err
+	}
+	s2, err := segmentsOf(t2)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := segmentIndex(s2)
+	if err != nil {
+		return nil, err
+	}
+
+	var points []geometry.Point
+	for _, s := range s1 {
+		for _, j := range idx.Search(segmentBBox(s, 0)) {
+			p, _, ok := planar.SegmentIntersection(s.a, s.b, s2[j].a, s2[j].b)
+			if ok && !containsPoint(points, p) {
+				points = append(points, p)
+			}
+		}
+	}
+	return pointCollection(points)
+}
+
+// segmentsOf returns the segments of the lines and of the rings of the polygons of the geometry.
+func segmentsOf(t interface{}) ([]segment, error) {
+	switch gtp := geometry.Pointer(t).(type) {
+	case *feature.Feature:
+		return segmentsOf(&gtp.Geometry)
+	case *feature.Collection:
+		var segments []segment
+		for i := range gtp.Features {
+			s, err := segmentsOf(&gtp.Features[i].Geometry)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, s...)
+		}
+		return segments, nil
+	case *geometry.Geometry:
+		o, err := gtp.ToObject()
+		if err != nil {
+			return nil, err
+		}
+		return segmentsOf(o)
+	case *geometry.LineString:
+		return lineSegments(nil, gtp.Coordinates), nil
+	case *geometry.MultiLineString:
+		var segments []segment
+		for _, ln := range gtp.Coordinates {
+			segments = lineSegments(segments, ln.Coordinates)
+		}
+		return segments, nil
+	case *geometry.Polygon:
+		var segments []segment
+		for _, r := range gtp.Coordinates {
+			segments = lineSegments(segments, r.Coordinates)
+		}
+		return segments, nil
+	case *geometry.MultiPolygon:
+		var segments []segment
+		for _, poly := range gtp.Coordinates {
+			for _, r := range poly.Coordinates {
+				segments = lineSegments(segments, r.Coordinates)
+			}
+		}
+		return segments, nil
+	case *geometry.Collection:
+		objects, err := gtp.Objects()
+		if err != nil {
+			return nil, err
+		}
+		var segments []segment
+		for _, o := range objects {
+			s, err := segmentsOf(o)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, s...)
+		}
+		return segments, nil
+	}
+	return nil, errors.New("geometry must be made of lines or polygons")
+}
+
+func lineSegments(segments []segment, coords []geometry.Point) []segment {
+	for i := 1; i < len(coords); i++ {
+		segments = append(segments, segment{a: coords[i-1], b: coords[i]})
+	}
+	return segments
+}
+
+// segmentIndex returns an index of the bounding boxes of the segments.
+func segmentIndex(segments []segment) (*index.Index, error) {
+	idx, err := index.New(len(segments), 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range segments {
+		idx.Add(segmentBBox(s, 0))
+	}
+	return idx, idx.Finish()
+}
+
+// segmentBBox returns the bounding box of the segment extended by the margin in degrees.
+func segmentBBox(s segment, margin float64) (float64, float64, float64, float64) {
+	return math.Min(s.a.Lng, s.b.Lng) - margin, math.Min(s.a.Lat, s.b.Lat) - margin,
+		math.Max(s.a.Lng, s.b.Lng) + margin, math.Max(s.a.Lat, s.b.Lat) + margin
+}
+
+func containsPoint(points []geometry.Point, p geometry.Point) bool {
+	for _, q := range points {
+		if planar.SamePoint(p, q) {
+			return true
+		}
+	}
+	return false
+}
+
+func pointCollection(points []geometry.Point) (*feature.Collection, error) {
+	features := make([]feature.Feature, 0, len(points))
+	for _, p := range points {
+		g, err := geometry.NewGeometry(p)
+		if err != nil {
+			return nil, err
+		}
+		f, err := feature.New(*g, nil, nil, feature.ID{})
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
@@ -0,0 +1,185 @@
+package misc
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/measurement"
+	"github.com/tomchavakis/turf-go/meta/coordAll"
+)
+
+// LineSlice takes a line, a start and a stop point and returns the part of the line between the points
+// where they are snapped on it. The points may be given in any order.
+// The lines of a MultiLineString are sliced as one line and every line they overlap is returned as a feature.
+// t can be a *geometry.LineString, a *geometry.MultiLineString, a *geometry.Geometry or a *feature.Feature of them.
+func LineSlice(start geometry.Point, stop geometry.Point, t interface{}) (*feature.Collection, error) {
+	startLocation, err := location(t, start)
+	if err != nil {
+		return nil, err
+	}
+	stopLocation, err := location(t, stop)
+	if err != nil {
+		return nil, err
+	}
+	if startLocation > stopLocation {
+		startLocation, stopLocation = stopLocation, startLocation
+	}
+	return LineSliceAlong(t, startLocation, stopLocation, constants.UnitDefault)
+}
+
+// LineSliceAlong takes a line and returns the part of it between the start and the stop distances along it.
+// The lines of a MultiLineString are sliced as one line and every line they overlap is returned as a feature.
+// t can be a *geometry.LineString, a *geometry.MultiLineString, a *geometry.Geometry or a *feature.Feature of them.
+func LineSliceAlong(t interface{}, startDist float64, stopDist float64, units string) (*feature.Collection, error) {
+	if startDist > stopDist {
+		return nil, errors.New("start distance cannot be greater than the stop distance")
+	}
+	lines, err := meta.LineStrings(t)
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []geometry.LineString
+	offset := 0.0
+	for _, ln := range lines {
+		l, err := measurement.Length(ln, units)
+		if err != nil {
+			return nil, err
+		}
+		part, err := sliceAlong(ln, l, startDist-offset, stopDist-offset, units)
+		if err != nil {
+			return nil, err
+		}
+		if part != nil {
+			parts = append(parts, *part)
+		}
+		offset += l
+	}
+	if len(parts) == 0 {
+		return nil, errors.New("start position is beyond the line")
+	}
+	return lineCollection(parts)
+}
+
+// chunkTolerance is the fraction of the chunk length under which the rest of a line isn't another chunk.
+const chunkTolerance = 1e-9
+
+// LineChunk divides a line into chunks of the given length. The last chunk of a line is shorter if its length
+// isn't a multiple of the chunk length. The lines of a MultiLineString are divided separately.
+// t can be a *geometry.LineString, a *geometry.MultiLineString, a *geometry.Geometry or a *feature.Feature of them.
+func LineChunk(t interface{}, segmentLength float64, units string) (*feature.Collection, error) {
+	if segmentLength <= 0 {
+		return nil, errors.New("segment length must be greater than 0")
+	}
+	lines, err := meta.LineStrings(t)
+	if err != nil {
+		return nil, err
+	}
+
+	var chunks []geometry.LineString
+	for _, ln := range lines {
+		l, err := measurement.Length(ln, units)
+		if err != nil {
+			return nil, err
+		}
+		// the tolerance keeps the float error of a length which is a multiple of the chunk length from adding a
+		// trailing chunk of almost no length
+		n := int(math.Ceil(l/segmentLength - chunkTolerance))
+		for i := 0; i < n; i++ {
+			chunk, err := sliceAlong(ln, l, float64(i)*segmentLength, float64(i+1)*segmentLength, units)
+			if err != nil {
+				return nil, err
+			}
+			if chunk != nil {
+				chunks = append(chunks, *chunk)
+			}
+		}
+	}
+	return lineCollection(chunks)
+}
+
+// LineSegment explodes a line into its segments, each of them returned as a LineString of two vertices.
+// t can be a *geometry.LineString, a *geometry.MultiLineString, a *geometry.Geometry or a *feature.Feature of them.
+func LineSegment(t interface{}) (*feature.Collection, error) {
+	lines, err := meta.LineStrings(t)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []geometry.LineString
+	for _, ln := range lines {
+		for i := 1; i < len(ln.Coordinates); i++ {
+			segments = append(segments, geometry.LineString{Coordinates: []geometry.Point{ln.Coordinates[i-1], ln.Coordinates[i]}})
+		}
+	}
+	return lineCollection(segments)
+}
+
+// location returns the distance along the line of the point where the given point is snapped on it.
+func location(t interface{}, p geometry.Point) (float64, error) {
+	f, err := measurement.NearestPointOnLine(t, p, constants.UnitDefault)
+	if err != nil {
+		return 0.0, err
+	}
+	return f.Properties["location"].(float64), nil
+}
+
+// sliceAlong returns the part of the line of the given length between the distances along it,
+// or nil if the line doesn't overlap them.
+func sliceAlong(ln geometry.LineString, length float64, startDist float64, stopDist float64, units string) (*geometry.LineString, error) {
+	if stopDist < 0 || startDist > length {
+		return nil, nil
+	}
+	from := math.Max(startDist, 0)
+	to := math.Min(stopDist, length)
+	// the line only touches the slice
+	if from == to && startDist != stopDist {
+		return nil, nil
+	}
+
+	start, err := measurement.Along(ln, from, units)
+	if err != nil {
+		return nil, err
+	}
+	coords := []geometry.Point{*start}
+	travelled := 0.0
+	for i := 1; i < len(ln.Coordinates); i++ {
+		d, err := measurement.PointDistance(ln.Coordinates[i-1], ln.Coordinates[i], units)
+		if err != nil {
+			return nil, err
+		}
+		travelled += d
+		if travelled >= to {
+			break
+		}
+		if travelled > from {
+			coords = append(coords, ln.Coordinates[i])
+		}
+	}
+	stop, err := measurement.Along(ln, to, units)
+	if err != nil {
+		return nil, err
+	}
+	coords = append(coords, *stop)
+
+	return geometry.NewLineString(coords)
+}
+
+func lineCollection(lines []geometry.LineString) (*feature.Collection, error) {
+	features := make([]feature.Feature, 0, len(lines))
+	for i := range lines {
+		g, err := geometry.NewGeometry(&lines[i])
+		if err != nil {
+			return nil, err
+		}
+		f, err := feature.New(*g, nil, nil, feature.ID{})
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
@@ -0,0 +1,280 @@
+package misc
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// a line along the equator with vertices one degree apart
+var equator = geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 3, Lat: 0}}}
+
+const degree = math.Pi / 180
+
+func lineStrings(t *testing.T, fc *feature.Collection) []geometry.LineString {
+	lines := make([]geometry.LineString, 0, len(fc.Features))
+	for _, f := range fc.Features {
+		ln, err := f.ToLineString()
+		if err != nil {
+			t.Fatalf("ToLineString error %v", err)
+		}
+		lines = append(lines, *ln)
+	}
+	return lines
+}
+
+func assertLngs(t *testing.T, ln geometry.LineString, want []float64) {
+	if len(ln.Coordinates) != len(want) {
+		t.Fatalf("got %v vertices, want %v", ln.Coordinates, want)
+	}
+	for i, c := range ln.Coordinates {
+		if math.Abs(c.Lng-want[i]) > 1e-9 || math.Abs(c.Lat) > 1e-9 {
+			t.Errorf("vertex %v = %v, want [%v, 0]", i, c, want[i])
+		}
+	}
+}
+
+func TestLineSlice(t *testing.T) {
+	fc, err := LineSlice(geometry.Point{Lng: 2.5, Lat: 1}, geometry.Point{Lng: 0.5, Lat: -1}, &equator)
+	if err != nil {
+		t.Errorf("LineSlice error %v", err)
+	}
+	lines := lineStrings(t, fc)
+	assert.Equal(t, len(lines), 1)
+	assertLngs(t, lines[0], []float64{0.5, 1, 2, 2.5})
+}
+
+func TestLineSliceAlong(t *testing.T) {
+	fc, err := LineSliceAlong(&equator, 0.5*degree, 1.5*degree, constants.UnitRadians)
+	if err != nil {
+		t.Errorf("LineSliceAlong error %v", err)
+	}
+	lines := lineStrings(t, fc)
+	assert.Equal(t, len(lines), 1)
+	assertLngs(t, lines[0], []float64{0.5, 1, 1.5})
+
+	// the stop distance is clamped to the end of the line
+	fc, err = LineSliceAlong(&equator, 2*degree, 10*degree, constants.UnitRadians)
+	if err != nil {
+		t.Errorf("LineSliceAlong error %v", err)
+	}
+	lines = lineStrings(t, fc)
+	assertLngs(t, lines[0], []float64{2, 3})
+
+	mln := geometry.MultiLineString{Coordinates: []geometry.LineString{
+		equator,
+		{Coordinates: []geometry.Point{{Lng: 10, Lat: 0}, {Lng: 12, Lat: 0}}},
+	}}
+	fc, err = LineSliceAlong(&mln, 2.5*degree, 4*degree, constants.UnitRadians)
+	if err != nil {
+		t.Errorf("LineSliceAlong error %v", err)
+	}
+	lines = lineStrings(t, fc)
+	assert.Equal(t, len(lines), 2)
+	assertLngs(t, lines[0], []float64{2.5, 3})
+	assertLngs(t, lines[1], []float64{10, 11})
+
+	_, err = LineSliceAlong(&equator, 4*degree, 5*degree, constants.UnitRadians)
+	if err == nil {
+		t.Errorf("LineSliceAlong expected an error for a start beyond the line")
+	}
+	_, err = LineSliceAlong(&equator, 2*degree, 1*degree, constants.UnitRadians)
+	if err == nil {
+		t.Errorf("LineSliceAlong expected an error for a start after the stop")
+	}
+}
+
+func TestLineChunk(t *testing.T) {
+	fc, err := LineChunk(&equator, 1.25*degree, constants.UnitRadians)
+	if err != nil {
+		t.Errorf("LineChunk error %v", err)
+	}
+	lines := lineStrings(t, fc)
+	assert.Equal(t, len(lines), 3)
+	assertLngs(t, lines[0], []float64{0, 1, 1.25})
+	assertLngs(t, lines[1], []float64{1.25, 2, 2.5})
+	assertLngs(t, lines[2], []float64{2.5, 3})
+
+	// the length of the line is a multiple of the chunk length, up to the float error
+	length, err := measurement.Length(equator, constants.UnitRadians)
+	if err != nil {
+		t.Errorf("Length error %v", err)
+	}
+	fc, err = LineChunk(&equator, length/11, constants.UnitRadians)
+	if err != nil {
+		t.Errorf("LineChunk error %v", err)
+	}
+	lines = lineStrings(t, fc)
+	assert.Equal(t, len(lines), 11)
+	assertLngs(t, lines[10], []float64{30.0 / 11, 3})
+
+	_, err = LineChunk(&equator, 0, constants.UnitRadians)
+	if err == nil {
+		t.Errorf("LineChunk expected an error for a zero segment length")
+	}
+}
+
+func TestLineSegment(t *testing.T) {
+	g, err := geometry.NewGeometry(&geometry.MultiLineString{Coordinates: []geometry.LineString{
+		equator,
+		{Coordinates: []geometry.Point{{Lng: 10, Lat: 0}, {Lng: 12, Lat: 0}}},
+	}})
+	if err != nil {
+		t.Errorf("NewGeometry error %v", err)
+	}
+	fc, err := LineSegment(g)
+	if err != nil {
+		t.Errorf("LineSegment error %v", err)
+	}
+	lines := lineStrings(t, fc)
+	assert.Equal(t, len(lines), 4)
+	assertLngs(t, lines[0], []float64{0, 1})
+	assertLngs(t, lines[2], []float64{2, 3})
+	assertLngs(t, lines[3], []float64{10, 12})
+
+	_, err = LineSegment(&geometry.Point{})
+	if err == nil {
+		t.Errorf("LineSegment expected an error for a point")
+	}
+}
+
+func points(t *testing.T, fc *feature.Collection) []geometry.Point {
+	points := make([]geometry.Point, 0, len(fc.Features))
+	for _, f := range fc.Features {
+		p, err := f.ToPoint()
+		if err != nil {
+			t.Fatalf("ToPoint error %v", err)
+		}
+		points = append(points, *p)
+	}
+	return points
+}
+
+func TestLineIntersect(t *testing.T) {
+	zigzag := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0.5, Lat: -1}, {Lng: 0.5, Lat: 1}, {Lng: 2.5, Lat: 1}, {Lng: 2.5, Lat: -1}}}
+	fc, err := LineIntersect(&equator, &zigzag)
+	if err != nil {
+		t.Errorf("LineIntersect error %v", err)
+	}
+	if got := points(t, fc); !reflect.DeepEqual(got, []geometry.Point{{Lng: 0.5, Lat: 0}, {Lng: 2.5, Lat: 0}}) {
+		t.Errorf("LineIntersect() = %v", got)
+	}
+
+	square := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 1, Lat: -1}, {Lng: 2, Lat: -1}, {Lng: 2, Lat: 1}, {Lng: 1, Lat: 1}, {Lng: 1, Lat: -1},
+	}}}}
+	fc, err = LineIntersect(&square, &equator)
+	if err != nil {
+		t.Errorf("LineIntersect error %v", err)
+	}
+	if got := points(t, fc); !reflect.DeepEqual(got, []geometry.Point{{Lng: 2, Lat: 0}, {Lng: 1, Lat: 0}}) {
+		t.Errorf("LineIntersect() = %v", got)
+	}
+
+	// the intersection at a shared vertex is returned once
+	v := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 1}, {Lng: 1, Lat: 0}, {Lng: 2, Lat: 1}}}
+	fc, err = LineIntersect(&equator, &v)
+	if err != nil {
+		t.Errorf("LineIntersect error %v", err)
+	}
+	if got := points(t, fc); !reflect.DeepEqual(got, []geometry.Point{{Lng: 1, Lat: 0}}) {
+		t.Errorf("LineIntersect() = %v", got)
+	}
+
+	// parallel segments don't intersect
+	parallel := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 3, Lat: 0}}}
+	fc, err = LineIntersect(&equator, &parallel)
+	if err != nil {
+		t.Errorf("LineIntersect error %v", err)
+	}
+	assert.Equal(t, len(fc.Features), 0)
+
+	_, err = LineIntersect(&equator, &geometry.Point{})
+	if err == nil {
+		t.Errorf("LineIntersect expected an error for a point")
+	}
+}
+
+func TestLineOverlap(t *testing.T) {
+	other := geometry.LineString{Coordinates: []geometry.Point{{Lng: 1, Lat: 1}, {Lng: 1, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 3, Lat: 0}, {Lng: 4, Lat: 1}}}
+	fc, err := LineOverlap(&equator, &other, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineOverlap error %v", err)
+	}
+	lines := lineStrings(t, fc)
+	assert.Equal(t, len(lines), 1)
+	assertLngs(t, lines[0], []float64{1, 2, 3})
+
+	// a long segment overlapping several shorter ones
+	long := geometry.LineString{Coordinates: []geometry.Point{{Lng: -1, Lat: 0}, {Lng: 2.5, Lat: 0}}}
+	fc, err = LineOverlap(&equator, &long, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineOverlap error %v", err)
+	}
+	lines = lineStrings(t, fc)
+	assert.Equal(t, len(lines), 1)
+	assertLngs(t, lines[0], []float64{0, 1, 2})
+
+	// within the tolerance
+	shifted := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0.25, Lat: 0.001}, {Lng: 0.75, Lat: 0.001}}}
+	fc, err = LineOverlap(&equator, &shifted, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineOverlap error %v", err)
+	}
+	assert.Equal(t, len(fc.Features), 0)
+	fc, err = LineOverlap(&equator, &shifted, 0.2, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineOverlap error %v", err)
+	}
+	assert.Equal(t, len(fc.Features), 1)
+}
+
+func TestLineSplit(t *testing.T) {
+	fc, err := LineSplit(&equator, &geometry.Point{Lng: 1.5, Lat: 0.5})
+	if err != nil {
+		t.Errorf("LineSplit error %v", err)
+	}
+	lines := lineStrings(t, fc)
+	assert.Equal(t, len(lines), 2)
+	assertLngs(t, lines[0], []float64{0, 1, 1.5})
+	assertLngs(t, lines[1], []float64{1.5, 2, 3})
+
+	// at a vertex and at the ends of the line
+	fc, err = LineSplit(&equator, &geometry.MultiPoint{Coordinates: []geometry.Point{{Lng: 2, Lat: 0}, {Lng: 0, Lat: 0}, {Lng: 3, Lat: 0}}})
+	if err != nil {
+		t.Errorf("LineSplit error %v", err)
+	}
+	lines = lineStrings(t, fc)
+	assert.Equal(t, len(lines), 2)
+	assertLngs(t, lines[0], []float64{0, 1, 2})
+	assertLngs(t, lines[1], []float64{2, 3})
+
+	square := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0.5, Lat: -1}, {Lng: 1.5, Lat: -1}, {Lng: 1.5, Lat: 1}, {Lng: 0.5, Lat: 1}, {Lng: 0.5, Lat: -1},
+	}}}}
+	fc, err = LineSplit(&equator, &square)
+	if err != nil {
+		t.Errorf("LineSplit error %v", err)
+	}
+	lines = lineStrings(t, fc)
+	assert.Equal(t, len(lines), 3)
+	assertLngs(t, lines[0], []float64{0, 0.5})
+	assertLngs(t, lines[1], []float64{0.5, 1, 1.5})
+	assertLngs(t, lines[2], []float64{1.5, 2, 3})
+
+	// a splitter which doesn't intersect returns the line
+	far := geometry.LineString{Coordinates: []geometry.Point{{Lng: 10, Lat: -1}, {Lng: 10, Lat: 1}}}
+	fc, err = LineSplit(&equator, &far)
+	if err != nil {
+		t.Errorf("LineSplit error %v", err)
+	}
+	lines = lineStrings(t, fc)
+	assert.Equal(t, len(lines), 1)
+	assertLngs(t, lines[0], []float64{0, 1, 2, 3})
+}
@@ -0,0 +1,129 @@
+package misc
+
+import (
+	"math"
+	"sort"
+
+	"github.com/tomchavakis/turf-go/conversions"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/planar"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// LineOverlap takes two geometries made of lines or polygons and returns the parts of the second geometry which
+// overlap the first. A segment overlaps when both its ends are within the tolerance from a segment of the other
+// geometry, a tolerance of 0 meaning that they must lie exactly on it. Consecutive overlapping segments are merged
+// into one LineString.
+// The segments of the first geometry are indexed, so that only the segments with close bounding boxes are compared.
+// t1 and t2 can be any typed geometry.Object, a *geometry.Geometry, a *feature.Feature or a *feature.Collection of
+// lines and polygons.
+func LineOverlap(t1 interface{}, t2 interface{}, tolerance float64, units string) (*feature.Collection, error) {
+	s1, err := segmentsOf(t1)
+	if err != nil {
+		return nil, err
+	}
+	s2, err := segmentsOf(t2)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := segmentIndex(s1)
+	if err != nil {
+		return nil, err
+	}
+	margin, err := conversions.LengthToDegrees(tolerance, units)
+	if err != nil {
+		return nil, err
+	}
+
+	var overlaps []geometry.LineString
+	for _, s := range s2 {
+		// a degree of longitude shrinks with the latitude
+		maxLat := math.Min(math.Max(math.Abs(s.a.Lat), math.Abs(s.b.Lat)), 89)
+		lngMargin := margin / math.Cos(conversions.DegreesToRadians(maxLat))
+		minX, minY, maxX, maxY := segmentBBox(s, planar.Epsilon)
+		candidates := idx.Search(minX-lngMargin, minY-margin, maxX+lngMargin, maxY+margin)
+
+		var pieces []segment
+		for _, j := range candidates {
+			m := s1[j]
+			on, err := segmentOnSegment(s, m, tolerance, units)
+			if err != nil {
+				return nil, err
+			}
+			if on {
+				pieces = []segment{s}
+				break
+			}
+			on, err = segmentOnSegment(m, s, tolerance, units)
+			if err != nil {
+				return nil, err
+			}
+			if on {
+				pieces = append(pieces, orient(m, s))
+			}
+		}
+		sort.Slice(pieces, func(i, j int) bool {
+			return along(pieces[i].a, s) < along(pieces[j].a, s)
+		})
+		for _, p := range pieces {
+			overlaps = concatSegment(overlaps, p)
+		}
+	}
+	return lineCollection(overlaps)
+}
+
+// segmentOnSegment returns true if both ends of s are within the tolerance from the segment on.
+func segmentOnSegment(s segment, on segment, tolerance float64, units string) (bool, error) {
+	for _, p := range []geometry.Point{s.a, s.b} {
+		if tolerance == 0 {
+			if !planar.OnSegment(p, on.a, on.b) {
+				return false, nil
+			}
+			continue
+		}
+		d, err := measurement.PointToLineDistance(p, &geometry.LineString{Coordinates: []geometry.Point{on.a, on.b}}, units, measurement.MethodGeodesic)
+		if err != nil {
+			return false, err
+		}
+		if d > tolerance {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// along returns the position of the projection of the point on the segment, as a fraction of its length.
+func along(p geometry.Point, s segment) float64 {
+	rx, ry := s.b.Lng-s.a.Lng, s.b.Lat-s.a.Lat
+	lengthSq := rx*rx + ry*ry
+	if lengthSq == 0 {
+		return 0
+	}
+	return ((p.Lng-s.a.Lng)*rx + (p.Lat-s.a.Lat)*ry) / lengthSq
+}
+
+// orient returns the segment s in the direction of the segment to.
+func orient(s segment, to segment) segment {
+	if along(s.b, to) < along(s.a, to) {
+		return segment{a: s.b, b: s.a}
+	}
+	return s
+}
+
+// concatSegment extends the last line with the segment if they share an end, otherwise the segment starts a new line.
+func concatSegment(lines []geometry.LineString, s segment) []geometry.LineString {
+	if len(lines) > 0 {
+		last := &lines[len(lines)-1]
+		coords := last.Coordinates
+		if planar.SamePoint(coords[len(coords)-1], s.a) {
+			last.Coordinates = append(coords, s.b)
+			return lines
+		}
+		if planar.SamePoint(coords[0], s.b) {
+			last.Coordinates = append([]geometry.Point{s.a}, coords...)
+			return lines
+		}
+	}
+	return append(lines, geometry.LineString{Coordinates: []geometry.Point{s.a, s.b}})
+}
@@ -0,0 +1,156 @@
+package misc
+
+import (
+	"sort"
+
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/index"
+	"github.com/tomchavakis/turf-go/internal/planar"
+	"github.com/tomchavakis/turf-go/meta/coordAll"
+)
+
+// cut is a position on a line where it is split.
+type cut struct {
+	// segment is the index of the segment of the line the cut lies on.
+	segment int
+	// t is the position of the cut along the segment, as a fraction of its length.
+	t float64
+	p geometry.Point
+}
+
+// LineSplit splits a line by a splitter and returns its parts. A Point or MultiPoint splitter splits the line where
+// its points are snapped on it, a splitter made of lines or polygons splits it where they intersect.
+// The lines of a MultiLineString are split separately.
+// line can be a *geometry.LineString, a *geometry.MultiLineString, a *geometry.Geometry or a *feature.Feature of them.
+// splitter can be any typed geometry.Object, a *geometry.Geometry or a *feature.Feature.
+func LineSplit(line interface{}, splitter interface{}) (*feature.Collection, error) {
+	lines, err := meta.LineStrings(line)
+	if err != nil {
+		return nil, err
+	}
+	points, err := splitterPoints(splitter)
+	if err != nil {
+		return nil, err
+	}
+	var splitterSegments []segment
+	var idx *index.Index
+	if points == nil {
+		splitterSegments, err = segmentsOf(splitter)
+		if err != nil {
+			return nil, err
+		}
+		idx, err = segmentIndex(splitterSegments)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var parts []geometry.LineString
+	for _, ln := range lines {
+		var cuts []cut
+		if points != nil {
+			for _, p := range points {
+				cuts = append(cuts, snapCut(ln.Coordinates, p))
+			}
+		} else {
+			for i := 1; i < len(ln.Coordinates); i++ {
+				s := segment{a: ln.Coordinates[i-1], b: ln.Coordinates[i]}
+				for _, j := range idx.Search(segmentBBox(s, 0)) {
+					if p, t, ok := planar.SegmentIntersection(s.a, s.b, splitterSegments[j].a, splitterSegments[j].b); ok {
+						cuts = append(cuts, cut{segment: i - 1, t: t, p: p})
+					}
+				}
+			}
+		}
+		parts = append(parts, splitLine(ln.Coordinates, cuts)...)
+	}
+	return lineCollection(parts)
+}
+
+// splitterPoints returns the points of a Point or MultiPoint splitter, or nil for other splitters.
+func splitterPoints(splitter interface{}) ([]geometry.Point, error) {
+	switch gtp := geometry.Pointer(splitter).(type) {
+	case *feature.Feature:
+		return splitterPoints(&gtp.Geometry)
+	case *geometry.Geometry:
+		o, err := gtp.ToObject()
+		if err != nil {
+			return nil, err
+		}
+		return splitterPoints(o)
+	case *geometry.Point:
+		return []geometry.Point{*gtp}, nil
+	case *geometry.MultiPoint:
+		return append([]geometry.Point{}, gtp.Coordinates...), nil
+	}
+	return nil, nil
+}
+
+// snapCut returns the cut at the position of the line closest to the point in the plane of the coordinates.
+func snapCut(line []geometry.Point, p geometry.Point) cut {
+	var best cut
+	minDist := -1.0
+	for i := 1; i < len(line); i++ {
+		s := segment{a: line[i-1], b: line[i]}
+		t := along(p, s)
+		if t < 0 {
+			t = 0
+		} else if t > 1 {
+			t = 1
+		}
+		q := geometry.Point{Lng: s.a.Lng + t*(s.b.Lng-s.a.Lng), Lat: s.a.Lat + t*(s.b.Lat-s.a.Lat)}
+		d := (q.Lng-p.Lng)*(q.Lng-p.Lng) + (q.Lat-p.Lat)*(q.Lat-p.Lat)
+		if minDist < 0 || d < minDist {
+			best = cut{segment: i - 1, t: t, p: q}
+			minDist = d
+		}
+	}
+	return best
+}
+
+// splitLine splits the line at the cuts. Cuts at the ends of the line are ignored.
+func splitLine(line []geometry.Point, cuts []cut) []geometry.LineString {
+	// a cut at the end of a segment is a cut at the start of the next one
+	for i := range cuts {
+		if cuts[i].t >= 1 && cuts[i].segment < len(line)-2 {
+			cuts[i] = cut{segment: cuts[i].segment + 1, t: 0, p: line[cuts[i].segment+1]}
+		}
+	}
+	sort.Slice(cuts, func(i, j int) bool {
+		if cuts[i].segment != cuts[j].segment {
+			return cuts[i].segment < cuts[j].segment
+		}
+		return cuts[i].t < cuts[j].t
+	})
+
+	var parts []geometry.LineString
+	current := []geometry.Point{line[0]}
+	c := 0
+	for i := 0; i < len(line)-1; i++ {
+		for ; c < len(cuts) && cuts[c].segment == i; c++ {
+			if cuts[c].t >= 1 {
+				// the end of the line
+				continue
+			}
+			p := cuts[c].p
+			if cuts[c].t <= 0 {
+				p = line[i]
+			}
+			if !planar.SamePoint(current[len(current)-1], p) {
+				current = append(current, p)
+			}
+			if len(current) > 1 {
+				parts = append(parts, geometry.LineString{Coordinates: current})
+				current = []geometry.Point{p}
+			}
+		}
+		if !planar.SamePoint(current[len(current)-1], line[i+1]) {
+			current = append(current, line[i+1])
+		}
+	}
+	if len(current) > 1 {
+		parts = append(parts, geometry.LineString{Coordinates: current})
+	}
+	return parts
+}
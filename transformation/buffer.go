@@ -0,0 +1,342 @@
+package transformation
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/conversions"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// maxPiece is the longest arc in radians, about 111km, between the points of the sides of a buffered segment.
+const maxPiece = math.Pi / 180
+
+// Buffer returns the area within the distance of a geometry, as a Polygon or a MultiPolygon with the overlapping
+// parts dissolved, or nil if it is empty.
+// The buffer is built on the sphere: every segment is surrounded by a capsule whose sides are offset along the great
+// circles perpendicular to it and whose ends are half circles of geodesic points, so that it keeps its size at high
+// latitudes. A buffer enclosing a pole is closed along the pole, and a buffer crossing the antimeridian is split
+// into the parts on either side of it. steps is the number of segments approximating a quarter of a circle.
+// A negative distance shrinks polygons and returns nil for points and lines.
+// t can be any typed geometry.Object, a *geometry.Geometry or a *feature.Feature.
+func Buffer(t interface{}, distance float64, units string, steps int) (geometry.Object, error) {
+	if steps < 1 {
+		return nil, errors.New("steps must be positive")
+	}
+	radians, err := conversions.LengthToRadians(distance, units)
+	if err != nil {
+		return nil, err
+	}
+	mp, err := bufferObject(t, radians, steps)
+	if err != nil {
+		return nil, err
+	}
+	return toObject(splitAntimeridian(mp))
+}
+
+func bufferObject(t interface{}, radians float64, steps int) (multiPolygon, error) {
+	switch gtp := geometry.Pointer(t).(type) {
+	case *feature.Feature:
+		return bufferObject(&gtp.Geometry, radians, steps)
+	case *geometry.Geometry:
+		o, err := gtp.ToObject()
+		if err != nil {
+			return nil, err
+		}
+		return bufferObject(o, radians, steps)
+	case *geometry.Point:
+		return bufferLine([]geometry.Point{*gtp}, radians, steps)
+	case *geometry.MultiPoint:
+		var parts []multiPolygon
+		for _, p := range gtp.Coordinates {
+			part, err := bufferLine([]geometry.Point{p}, radians, steps)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, part)
+		}
+		return dissolve(parts), nil
+	case *geometry.LineString:
+		return bufferLine(gtp.Coordinates, radians, steps)
+	case *geometry.MultiLineString:
+		var parts []multiPolygon
+		for _, ln := range gtp.Coordinates {
+			part, err := bufferLine(ln.Coordinates, radians, steps)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, part)
+		}
+		return dissolve(parts), nil
+	case *geometry.Polygon:
+		return bufferPolygon(*gtp, radians, steps)
+	case *geometry.MultiPolygon:
+		var parts []multiPolygon
+		for _, poly := range gtp.Coordinates {
+			part, err := bufferPolygon(poly, radians, steps)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, part)
+		}
+		return dissolve(parts), nil
+	case *geometry.Collection:
+		var parts []multiPolygon
+		for i := range gtp.Geometries {
+			part, err := bufferObject(&gtp.Geometries[i], radians, steps)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, part)
+		}
+		return dissolve(parts), nil
+	}
+	return nil, errors.New("unknown geometry type")
+}
+
+// bufferPolygon grows the polygon by the buffer of its rings, or shrinks it by them for a negative distance.
+func bufferPolygon(poly geometry.Polygon, radians float64, steps int) (multiPolygon, error) {
+	subject := multiPolygon{polygonRings(poly)}
+	if radians == 0 {
+		return subject, nil
+	}
+	var parts []multiPolygon
+	for _, r := range poly.Coordinates {
+		part, err := bufferLine(r.Coordinates, math.Abs(radians), steps)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part)
+	}
+	if radians < 0 {
+		return boolean(subject, dissolve(parts), difference), nil
+	}
+	return dissolve(append(parts, subject)), nil
+}
+
+// bufferLine returns the union of the capsules around the segments of the line, or the circle around its point.
+func bufferLine(coords []geometry.Point, radians float64, steps int) (multiPolygon, error) {
+	if radians <= 0 || len(coords) == 0 {
+		return nil, nil
+	}
+
+	// keep the longitudes continuous across the antimeridian
+	line := []geometry.Point{coords[0]}
+	for _, p := range coords[1:] {
+		prev := line[len(line)-1]
+		p.Lng = prev.Lng + math.Remainder(p.Lng-prev.Lng, 360)
+		if p.Lng != prev.Lng || p.Lat != prev.Lat {
+			line = append(line, p)
+		}
+	}
+
+	if len(line) == 1 {
+		c, err := arc(line[0], radians, 0, 360, steps)
+		if err != nil {
+			return nil, err
+		}
+		return multiPolygon{{aroundPole(append(c, c[0]))}}, nil
+	}
+	var parts []multiPolygon
+	for i := 1; i < len(line); i++ {
+		c, err := capsule(line[i-1], line[i], radians, steps)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, multiPolygon{{aroundPole(c)}})
+	}
+	return dissolve(parts), nil
+}
+
+// capsule returns the ring around the segment from a to b: its sides are at the distance from the great circle arc
+// and its ends are half circles around a and b.
+func capsule(a geometry.Point, b geometry.Point, radians float64, steps int) ([][2]float64, error) {
+	d, err := measurement.PointDistance(a, b, constants.UnitRadians)
+	if err != nil {
+		return nil, err
+	}
+	n := int(math.Ceil(d / maxPiece))
+	start := measurement.PointBearing(a, b)
+	end := measurement.PointBearing(b, a) + 180
+
+	left := make([][2]float64, 0, n+1)
+	right := make([][2]float64, 0, n+1)
+	for i := 0; i <= n; i++ {
+		p := a
+		bearing := start
+		if i == n {
+			p = b
+			bearing = end
+		} else if i > 0 {
+			q, err := measurement.Destination(a, d*float64(i)/float64(n), start, constants.UnitRadians)
+			if err != nil {
+				return nil, err
+			}
+			p = geometry.Point{Lng: a.Lng + math.Remainder(q.Lng-a.Lng, 360), Lat: q.Lat}
+			bearing = measurement.PointBearing(p, b)
+		}
+		l, err := offset(p, radians, bearing-90)
+		if err != nil {
+			return nil, err
+		}
+		r, err := offset(p, radians, bearing+90)
+		if err != nil {
+			return nil, err
+		}
+		left = append(left, l)
+		right = append(right, r)
+	}
+
+	ring := append([][2]float64{}, left...)
+	front, err := arc(b, radians, end-90, end+90, steps)
+	if err != nil {
+		return nil, err
+	}
+	ring = append(ring, front...)
+	for i := len(right) - 1; i >= 0; i-- {
+		ring = append(ring, right[i])
+	}
+	back, err := arc(a, radians, start+90, start+270, steps)
+	if err != nil {
+		return nil, err
+	}
+	ring = append(ring, back...)
+	return append(ring, ring[0]), nil
+}
+
+// arc returns the points at the distance from the center with the bearings between from and to, clockwise, which
+// are multiples of a quarter of a circle divided by steps, so that the arcs of different capsules around the same
+// vertex share their points. A full circle returns all of them.
+func arc(center geometry.Point, radians float64, from float64, to float64, steps int) ([][2]float64, error) {
+	n := 4 * steps
+	step := 360 / float64(n)
+	full := to-from >= 360
+	// skip the bearings too close to the ends, which would make tiny edges
+	margin := step * 1e-6
+	var points [][2]float64
+	for k := math.Ceil(from / step); ; k++ {
+		bearing := k * step
+		if (full && bearing >= from+360) || (!full && bearing >= to-margin) {
+			break
+		}
+		if !full && bearing-from <= margin {
+			continue
+		}
+		p, err := offset(center, radians, float64((int(k)%n+n)%n)*step)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
+
+// offset returns the destination from the point, its longitude kept within 180 degrees from the point.
+func offset(p geometry.Point, radians float64, bearing float64) ([2]float64, error) {
+	q, err := measurement.Destination(p, radians, bearing, constants.UnitRadians)
+	if err != nil {
+		return [2]float64{}, err
+	}
+	return [2]float64{p.Lng + math.Remainder(q.Lng-p.Lng, 360), q.Lat}, nil
+}
+
+// aroundPole returns the closed ring of a buffer closed along the pole it goes around, or the ring unchanged if it
+// doesn't go around a pole. The longitudes of a ring around a pole turn by 360 degrees: the ring is cut at the
+// antimeridian and joined to the pole by the meridians of 180 and -180 degrees, so that it covers the cap in the
+// plane of the longitudes and latitudes.
+func aroundPole(ring [][2]float64) [][2]float64 {
+	n := len(ring)
+	lngs := make([]float64, n)
+	lngs[0] = ring[0][0]
+	for i := 1; i < n; i++ {
+		lngs[i] = lngs[i-1] + math.Remainder(ring[i][0]-ring[i-1][0], 360)
+	}
+	turn := lngs[n-1] - lngs[0]
+	if math.Abs(turn) < 180 {
+		return ring
+	}
+
+	// the rings of a buffer go clockwise, westward around the north pole and eastward around the south pole
+	pole, start := 90.0, 180.0
+	if turn > 0 {
+		pole, start = -90, -180
+	}
+	// the first edge crossing the antimeridian, at the longitude c
+	i := 1
+	for math.Floor((lngs[i-1]-180)/360) == math.Floor((lngs[i]-180)/360) {
+		i++
+	}
+	c := 180 + 360*math.Max(math.Floor((lngs[i-1]-180)/360), math.Floor((lngs[i]-180)/360))
+	f := (c - lngs[i-1]) / (lngs[i] - lngs[i-1])
+	lat := ring[i-1][1] + f*(ring[i][1]-ring[i-1][1])
+
+	result := [][2]float64{{start, lat}}
+	add := func(lng float64, lat float64) {
+		p := [2]float64{math.Max(-180, math.Min(180, lng)), lat}
+		if p != result[len(result)-1] {
+			result = append(result, p)
+		}
+	}
+	for j := i; j < n; j++ {
+		add(lngs[j]-c+start, ring[j][1])
+	}
+	for j := 1; j < i; j++ {
+		add(lngs[j]+turn-c+start, ring[j][1])
+	}
+	add(-start, lat)
+	add(-start, pole)
+	add(start, pole)
+	return append(result, result[0])
+}
+
+// splitAntimeridian cuts the polygons at the antimeridian and moves the parts beyond it by 360 degrees of longitude.
+func splitAntimeridian(mp multiPolygon) multiPolygon {
+	west, east := math.Inf(1), math.Inf(-1)
+	for _, rings := range mp {
+		for _, r := range rings {
+			for _, p := range r {
+				west = math.Min(west, p[0])
+				east = math.Max(east, p[0])
+			}
+		}
+	}
+	if west >= -180 && east <= 180 {
+		return mp
+	}
+
+	var parts []multiPolygon
+	for k := math.Floor((west + 180) / 360); 360*k-180 < east; k++ {
+		lo, hi := 360*k-180, 360*k+180
+		part := boolean(mp, multiPolygon{{{{lo, -90}, {hi, -90}, {hi, 90}, {lo, 90}, {lo, -90}}}}, intersection)
+		for _, rings := range part {
+			for _, r := range rings {
+				for j := range r {
+					r[j][0] -= 360 * k
+				}
+			}
+		}
+		parts = append(parts, part)
+	}
+	return dissolve(parts)
+}
+
+// dissolve returns the union of the polygons, merging them in pairs so that every union handles similar sizes.
+func dissolve(parts []multiPolygon) multiPolygon {
+	if len(parts) == 0 {
+		return nil
+	}
+	for len(parts) > 1 {
+		var merged []multiPolygon
+		for i := 0; i+1 < len(parts); i += 2 {
+			merged = append(merged, boolean(parts[i], parts[i+1], union))
+		}
+		if len(parts)%2 == 1 {
+			merged = append(merged, parts[len(parts)-1])
+		}
+		parts = merged
+	}
+	return parts[0]
+}
@@ -0,0 +1,244 @@
+package transformation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/booleans"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// assertArea checks that the area of the buffer is within 1% of the expected area in square meters.
+func assertArea(t *testing.T, o geometry.Object, want float64) {
+	g, err := geometry.NewGeometry(o)
+	if err != nil {
+		t.Fatalf("NewGeometry error %v", err)
+	}
+	got, err := measurement.Area(g)
+	if err != nil {
+		t.Fatalf("Area error %v", err)
+	}
+	if math.Abs(got-want) > want/100 {
+		t.Errorf("area = %v, want %v", got, want)
+	}
+}
+
+func TestBufferPoint(t *testing.T) {
+	// a circle keeps its radius at high latitudes
+	tests := map[string]struct {
+		point geometry.Point
+	}{
+		"equator": {point: geometry.Point{Lng: 10, Lat: 0}},
+		"north":   {point: geometry.Point{Lng: 10, Lat: 75}},
+		"south":   {point: geometry.Point{Lng: -70, Lat: -80}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			o, err := Buffer(&tt.point, 100, constants.UnitKilometers, 16)
+			if err != nil {
+				t.Fatalf("Buffer error %v", err)
+			}
+			poly, ok := o.(*geometry.Polygon)
+			if !ok {
+				t.Fatalf("Buffer() = %v, want a Polygon", o)
+			}
+			assert.Equal(t, len(poly.Coordinates[0].Coordinates), 65)
+			for _, p := range poly.Coordinates[0].Coordinates {
+				d, err := measurement.PointDistance(tt.point, p, constants.UnitKilometers)
+				if err != nil {
+					t.Fatalf("PointDistance error %v", err)
+				}
+				if math.Abs(d-100) > 1e-6 {
+					t.Errorf("vertex %v is %v km away", p, d)
+				}
+			}
+			r := 100000 / constants.EarthRadius
+			assertArea(t, o, 2*math.Pi*constants.EarthRadius*constants.EarthRadius*(1-math.Cos(r)))
+		})
+	}
+
+	o, err := Buffer(&geometry.Point{Lng: 10, Lat: 0}, -100, constants.UnitKilometers, 8)
+	if err != nil {
+		t.Errorf("Buffer error %v", err)
+	}
+	assert.Equal(t, o, nil)
+}
+
+func TestBufferDissolve(t *testing.T) {
+	// overlapping circles are merged, distant ones are kept apart
+	mp := geometry.MultiPoint{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 10, Lat: 0}}}
+	o, err := Buffer(&mp, 100, constants.UnitKilometers, 8)
+	if err != nil {
+		t.Fatalf("Buffer error %v", err)
+	}
+	multi, ok := o.(*geometry.MultiPolygon)
+	if !ok {
+		t.Fatalf("Buffer() = %v, want a MultiPolygon", o)
+	}
+	assert.Equal(t, len(multi.Coordinates), 2)
+}
+
+func TestBufferLine(t *testing.T) {
+	// a corridor along the equator has the area of a rectangle with two half circles at its ends
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 4, Lat: 0}}}
+	o, err := Buffer(&ln, 10, constants.UnitKilometers, 16)
+	if err != nil {
+		t.Fatalf("Buffer error %v", err)
+	}
+	poly, ok := o.(*geometry.Polygon)
+	if !ok {
+		t.Fatalf("Buffer() = %v, want a Polygon", o)
+	}
+	assert.Equal(t, len(poly.Coordinates), 1)
+	length := 4 * math.Pi / 180 * constants.EarthRadius
+	assertArea(t, o, 2*10000*length+math.Pi*10000*10000)
+
+}
+
+func TestBufferPole(t *testing.T) {
+	// a circle around a pole is closed along the pole and keeps its area
+	tests := map[string]struct {
+		point    geometry.Point
+		distance float64
+	}{
+		"north": {point: geometry.Point{Lng: 0, Lat: 89.9}, distance: 50},
+		"south": {point: geometry.Point{Lng: -120, Lat: -89.8}, distance: 50},
+		"far":   {point: geometry.Point{Lng: 135, Lat: 89}, distance: 150},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			o, err := Buffer(&tt.point, tt.distance, constants.UnitKilometers, 16)
+			if err != nil {
+				t.Fatalf("Buffer error %v", err)
+			}
+			poly, ok := o.(*geometry.Polygon)
+			if !ok {
+				t.Fatalf("Buffer() = %v, want a Polygon", o)
+			}
+			assertLongitudes(t, o)
+			contains, err := booleans.Contains(poly, &tt.point)
+			if err != nil {
+				t.Fatalf("Contains error %v", err)
+			}
+			assert.Equal(t, contains, true)
+			r := tt.distance * 1000 / constants.EarthRadius
+			assertArea(t, o, 2*math.Pi*constants.EarthRadius*constants.EarthRadius*(1-math.Cos(r)))
+		})
+	}
+
+	// a corridor passing by the pole
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: -10, Lat: 89.8}, {Lng: 10, Lat: 89.8}}}
+	o, err := Buffer(&ln, 50, constants.UnitKilometers, 16)
+	if err != nil {
+		t.Fatalf("Buffer error %v", err)
+	}
+	_, ok := o.(*geometry.Polygon)
+	assert.Equal(t, ok, true)
+	assertLongitudes(t, o)
+	length, err := measurement.Length(&ln, constants.UnitMeters)
+	if err != nil {
+		t.Fatalf("Length error %v", err)
+	}
+	assertArea(t, o, 2*50000*length+math.Pi*50000*50000)
+}
+
+func TestBufferAntimeridian(t *testing.T) {
+	// a buffer across the antimeridian is split into the parts on either side of it
+	tests := map[string]struct {
+		geometry geometry.Object
+		area     float64
+	}{
+		"point": {
+			geometry: &geometry.Point{Lng: 179.9, Lat: 0},
+			area:     2 * math.Pi * constants.EarthRadius * constants.EarthRadius * (1 - math.Cos(50000/constants.EarthRadius)),
+		},
+		"line": {
+			geometry: &geometry.LineString{Coordinates: []geometry.Point{{Lng: 179.5, Lat: 0}, {Lng: -179.5, Lat: 0}}},
+			area:     2*50000*math.Pi/180*constants.EarthRadius + math.Pi*50000*50000,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			o, err := Buffer(tt.geometry, 50, constants.UnitKilometers, 16)
+			if err != nil {
+				t.Fatalf("Buffer error %v", err)
+			}
+			multi, ok := o.(*geometry.MultiPolygon)
+			if !ok {
+				t.Fatalf("Buffer() = %v, want a MultiPolygon", o)
+			}
+			assert.Equal(t, len(multi.Coordinates), 2)
+			assertLongitudes(t, o)
+			assertArea(t, o, tt.area)
+		})
+	}
+}
+
+// assertLongitudes checks that the longitudes of the buffer are between -180 and 180.
+func assertLongitudes(t *testing.T, o geometry.Object) {
+	var polygons []geometry.Polygon
+	switch g := o.(type) {
+	case *geometry.Polygon:
+		polygons = []geometry.Polygon{*g}
+	case *geometry.MultiPolygon:
+		polygons = g.Coordinates
+	}
+	for _, poly := range polygons {
+		for _, r := range poly.Coordinates {
+			for _, p := range r.Coordinates {
+				if p.Lng < -180 || p.Lng > 180 {
+					t.Errorf("vertex %v beyond the antimeridian", p)
+				}
+			}
+		}
+	}
+}
+
+func TestBufferPolygon(t *testing.T) {
+	square := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 1}, {Lng: 0, Lat: 0},
+	}}}}
+	area, err := measurement.Area(&square)
+	if err != nil {
+		t.Fatalf("Area error %v", err)
+	}
+	side := math.Sqrt(area)
+
+	o, err := Buffer(&square, 10, constants.UnitKilometers, 16)
+	if err != nil {
+		t.Fatalf("Buffer error %v", err)
+	}
+	assertArea(t, o, area+4*side*10000+math.Pi*10000*10000)
+
+	// a polygon passed by value
+	o, err = Buffer(square, 10, constants.UnitKilometers, 16)
+	if err != nil {
+		t.Fatalf("Buffer error %v", err)
+	}
+	assertArea(t, o, area+4*side*10000+math.Pi*10000*10000)
+
+	o, err = Buffer(&square, -10, constants.UnitKilometers, 16)
+	if err != nil {
+		t.Fatalf("Buffer error %v", err)
+	}
+	assertArea(t, o, (side-20000)*(side-20000))
+
+	// a polygon shrunk to nothing
+	o, err = Buffer(&square, -100, constants.UnitKilometers, 8)
+	if err != nil {
+		t.Fatalf("Buffer error %v", err)
+	}
+	assert.Equal(t, o, nil)
+
+	_, err = Buffer(&square, 10, "parsecs", 8)
+	if err == nil {
+		t.Errorf("Buffer expected an error for invalid units")
+	}
+	_, err = Buffer(&square, 10, constants.UnitKilometers, 0)
+	if err == nil {
+		t.Errorf("Buffer expected an error for zero steps")
+	}
+}
@@ -0,0 +1,640 @@
+package transformation
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// snapTolerance is the distance, relative to the size of the coordinates, within which an end point of a segment is
+// considered to lie on another segment.
+const snapTolerance = 1e-12
+
+// boolean operations of the clipping algorithm
+const (
+	intersection = iota
+	union
+	difference
+	xor
+)
+
+// multiPolygon holds the rings of the polygons as closed lists of points, the outer ring of every polygon first.
+type multiPolygon [][][][2]float64
+
+// Union returns the union of two polygons, the area covered by any of them.
+// The polygons are clipped with the Martinez-Rueda-Feito algorithm which handles holes, self overlapping edges and
+// edges overlapping the edges of the other polygon.
+// https://doi.org/10.1016/j.advengsoft.2013.04.004
+// The result is a Polygon or a MultiPolygon with counterclockwise outer rings and clockwise holes, or nil if it is empty.
+// p1 and p2 can be a *geometry.Polygon, a *geometry.MultiPolygon, a *geometry.Geometry or a *feature.Feature of them.
+func Union(p1 interface{}, p2 interface{}) (geometry.Object, error) {
+	return clip(p1, p2, union)
+}
+
+// Intersect returns the intersection of two polygons, the area covered by both of them.
+// The result is a Polygon or a MultiPolygon, or nil if the polygons don't intersect. See Union.
+func Intersect(p1 interface{}, p2 interface{}) (geometry.Object, error) {
+	return clip(p1, p2, intersection)
+}
+
+// Difference returns the difference of two polygons, the area of the first polygon not covered by the second.
+// The result is a Polygon or a MultiPolygon, or nil if it is empty. See Union.
+func Difference(p1 interface{}, p2 interface{}) (geometry.Object, error) {
+	return clip(p1, p2, difference)
+}
+
+// Xor returns the symmetric difference of two polygons, the area covered by exactly one of them.
+// The result is a Polygon or a MultiPolygon, or nil if it is empty. See Union.
+func Xor(p1 interface{}, p2 interface{}) (geometry.Object, error) {
+	return clip(p1, p2, xor)
+}
+
+func clip(p1 interface{}, p2 interface{}, operation int) (geometry.Object, error) {
+	subject, err := toMultiPolygon(p1)
+	if err != nil {
+		return nil, err
+	}
+	clipping, err := toMultiPolygon(p2)
+	if err != nil {
+		return nil, err
+	}
+	return toObject(boolean(subject, clipping, operation))
+}
+
+// boolean applies the operation on the polygons.
+func boolean(subject multiPolygon, clipping multiPolygon, operation int) multiPolygon {
+	if result, ok := trivialOperation(subject, clipping, operation); ok {
+		return result
+	}
+
+	sbbox := [4]float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	cbbox := [4]float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	queue := fillQueue(subject, clipping, &sbbox, &cbbox, operation)
+
+	// polygons with disjoint bounding boxes don't intersect
+	if sbbox[0] > cbbox[2] || cbbox[0] > sbbox[2] || sbbox[1] > cbbox[3] || cbbox[1] > sbbox[3] {
+		switch operation {
+		case intersection:
+			return nil
+		case difference:
+			return subject
+		default:
+			return append(append(multiPolygon{}, subject...), clipping...)
+		}
+	}
+
+	sortedEvents := subdivideSegments(queue, sbbox, cbbox, operation)
+	return connectEdges(sortedEvents)
+}
+
+// trivialOperation returns the result of the operation when one of the polygons is empty.
+func trivialOperation(subject multiPolygon, clipping multiPolygon, operation int) (multiPolygon, bool) {
+	if len(subject) > 0 && len(clipping) > 0 {
+		return nil, false
+	}
+	switch operation {
+	case intersection:
+		return nil, true
+	case difference:
+		return subject, true
+	}
+	if len(subject) == 0 {
+		return clipping, true
+	}
+	return subject, true
+}
+
+// fillQueue returns the queue of the events of the edges of the polygons and calculates their bounding boxes.
+func fillQueue(subject multiPolygon, clipping multiPolygon, sbbox *[4]float64, cbbox *[4]float64, operation int) *eventQueue {
+	queue := &eventQueue{}
+	contourID := 0
+	for _, poly := range subject {
+		contourID++
+		for _, ring := range poly {
+			processRing(ring, true, contourID, queue, sbbox)
+		}
+	}
+	for _, poly := range clipping {
+		contourID++
+		for _, ring := range poly {
+			processRing(ring, false, contourID, queue, cbbox)
+		}
+	}
+	heap.Init(queue)
+	return queue
+}
+
+func processRing(ring [][2]float64, isSubject bool, contourID int, queue *eventQueue, bbox *[4]float64) {
+	for i := 0; i < len(ring)-1; i++ {
+		s1 := ring[i]
+		s2 := ring[i+1]
+		// skip collapsed edges
+		if s1 == s2 {
+			continue
+		}
+		e1 := newSweepEvent(s1, false, nil, isSubject)
+		e2 := newSweepEvent(s2, false, e1, isSubject)
+		e1.otherEvent = e2
+		e1.contourID = contourID
+		e2.contourID = contourID
+		if compareEvents(e1, e2) > 0 {
+			e2.left = true
+		} else {
+			e1.left = true
+		}
+
+		bbox[0] = math.Min(bbox[0], s1[0])
+		bbox[1] = math.Min(bbox[1], s1[1])
+		bbox[2] = math.Max(bbox[2], s1[0])
+		bbox[3] = math.Max(bbox[3], s1[1])
+
+		*queue = append(*queue, e1, e2)
+	}
+}
+
+// subdivideSegments sweeps the plane from left to right, splitting the edges where they intersect, computing which
+// edges are part of the result and returning the events in the order they were processed.
+func subdivideSegments(queue *eventQueue, sbbox [4]float64, cbbox [4]float64, operation int) []*sweepEvent {
+	var sweepLine []*sweepEvent
+	var sortedEvents []*sweepEvent
+	rightBound := math.Min(sbbox[2], cbbox[2])
+
+	for queue.Len() > 0 {
+		event := heap.Pop(queue).(*sweepEvent)
+		sortedEvents = append(sortedEvents, event)
+
+		// nothing right of the polygons affects the result
+		if (operation == intersection && event.point[0] > rightBound) ||
+			(operation == difference && event.point[0] > sbbox[2]) {
+			break
+		}
+
+		if event.left {
+			pos := sort.Search(len(sweepLine), func(i int) bool {
+				return compareSegments(sweepLine[i], event) > 0
+			})
+			sweepLine = append(sweepLine, nil)
+			copy(sweepLine[pos+1:], sweepLine[pos:])
+			sweepLine[pos] = event
+
+			var prev, next *sweepEvent
+			if pos > 0 {
+				prev = sweepLine[pos-1]
+			}
+			if pos < len(sweepLine)-1 {
+				next = sweepLine[pos+1]
+			}
+
+			computeFields(event, prev, operation)
+			if next != nil && possibleIntersection(event, next, queue) == 2 {
+				computeFields(event, prev, operation)
+				computeFields(next, event, operation)
+			}
+			if prev != nil && possibleIntersection(prev, event, queue) == 2 {
+				var prevPrev *sweepEvent
+				if pos > 1 {
+					prevPrev = sweepLine[pos-2]
+				}
+				computeFields(prev, prevPrev, operation)
+				computeFields(event, prev, operation)
+			}
+		} else {
+			event = event.otherEvent
+			pos := -1
+			for i, e := range sweepLine {
+				if e == event {
+					pos = i
+					break
+				}
+			}
+			if pos >= 0 {
+				var prev, next *sweepEvent
+				if pos > 0 {
+					prev = sweepLine[pos-1]
+				}
+				if pos < len(sweepLine)-1 {
+					next = sweepLine[pos+1]
+				}
+				sweepLine = append(sweepLine[:pos], sweepLine[pos+1:]...)
+				if prev != nil && next != nil {
+					possibleIntersection(prev, next, queue)
+				}
+			}
+		}
+	}
+	return sortedEvents
+}
+
+// computeFields computes the transition fields of the event from the closest edge below it in the sweep line.
+func computeFields(event *sweepEvent, prev *sweepEvent, operation int) {
+	if prev == nil {
+		event.inOut = false
+		event.otherInOut = true
+	} else if event.isSubject == prev.isSubject {
+		// the previous edge belongs to the same polygon
+		event.inOut = !prev.inOut
+		event.otherInOut = prev.otherInOut
+	} else {
+		// the previous edge belongs to the other polygon
+		event.inOut = !prev.otherInOut
+		if prev.isVertical() {
+			event.otherInOut = !prev.inOut
+		} else {
+			event.otherInOut = prev.inOut
+		}
+	}
+
+	event.resultTransition = 0
+	if inResult(event, operation) {
+		event.resultTransition = resultTransition(event, operation)
+	}
+}
+
+// inResult returns true if the edge of the event is part of the result of the operation.
+func inResult(event *sweepEvent, operation int) bool {
+	switch event.edgeType {
+	case normal:
+		switch operation {
+		case intersection:
+			return !event.otherInOut
+		case union:
+			return event.otherInOut
+		case difference:
+			return (event.isSubject && event.otherInOut) || (!event.isSubject && !event.otherInOut)
+		case xor:
+			return true
+		}
+	case sameTransition:
+		return operation == intersection || operation == union
+	case differentTransition:
+		return operation == difference
+	}
+	return false
+}
+
+// resultTransition returns 1 if the edge of the event is an out-in transition of the result, the inside of the
+// result lying above it, and -1 otherwise.
+func resultTransition(event *sweepEvent, operation int) int {
+	thisIn := !event.inOut
+	thatIn := !event.otherInOut
+	// above an edge overlapping an edge of the other polygon, the other polygon has the transition of that edge
+	switch event.edgeType {
+	case sameTransition:
+		thatIn = thisIn
+	case differentTransition:
+		thatIn = !thisIn
+	}
+	var isIn bool
+	switch operation {
+	case intersection:
+		isIn = thisIn && thatIn
+	case union:
+		isIn = thisIn || thatIn
+	case xor:
+		isIn = thisIn != thatIn
+	case difference:
+		if event.isSubject {
+			isIn = thisIn && !thatIn
+		} else {
+			isIn = thatIn && !thisIn
+		}
+	}
+	if isIn {
+		return 1
+	}
+	return -1
+}
+
+// possibleIntersection splits the edges of the events where they intersect. It returns 0 if they don't intersect
+// or only share an end point, 1 if they intersect at a point, 2 if they overlap from the same left end point and
+// 3 if they overlap otherwise.
+func possibleIntersection(se1 *sweepEvent, se2 *sweepEvent, queue *eventQueue) int {
+	inter := segmentIntersection(se1.point, se1.otherEvent.point, se2.point, se2.otherEvent.point)
+	if len(inter) == 0 {
+		return 0
+	}
+
+	// the edges intersect at an end point of both of them
+	if len(inter) == 1 && (se1.point == se2.point || se1.otherEvent.point == se2.otherEvent.point) {
+		return 0
+	}
+
+	// overlapping edges of the same polygon
+	if len(inter) == 2 && se1.isSubject == se2.isSubject {
+		return 0
+	}
+
+	if len(inter) == 1 {
+		if within(inter[0], se1) {
+			divideSegment(se1, inter[0], queue)
+		}
+		if within(inter[0], se2) {
+			divideSegment(se2, inter[0], queue)
+		}
+		return 1
+	}
+
+	// the edges overlap
+	var events []*sweepEvent
+	leftCoincide := false
+	rightCoincide := false
+
+	if se1.point == se2.point {
+		leftCoincide = true
+	} else if compareEvents(se1, se2) == 1 {
+		events = append(events, se2, se1)
+	} else {
+		events = append(events, se1, se2)
+	}
+
+	if se1.otherEvent.point == se2.otherEvent.point {
+		rightCoincide = true
+	} else if compareEvents(se1.otherEvent, se2.otherEvent) == 1 {
+		events = append(events, se2.otherEvent, se1.otherEvent)
+	} else {
+		events = append(events, se1.otherEvent, se2.otherEvent)
+	}
+
+	if leftCoincide {
+		// the edges are equal or share the left end point
+		se2.edgeType = nonContributing
+		if se2.inOut == se1.inOut {
+			se1.edgeType = sameTransition
+		} else {
+			se1.edgeType = differentTransition
+		}
+		if !rightCoincide {
+			divideSegment(events[1].otherEvent, events[0].point, queue)
+		}
+		return 2
+	}
+
+	// the edges share the right end point
+	if rightCoincide {
+		divideSegment(events[0], events[1].point, queue)
+		return 3
+	}
+
+	// no edge includes the other one
+	if events[0] != events[3].otherEvent {
+		divideSegment(events[0], events[1].point, queue)
+		divideSegment(events[1], events[2].point, queue)
+		return 3
+	}
+
+	// one edge includes the other one
+	divideSegment(events[0], events[1].point, queue)
+	divideSegment(events[3].otherEvent, events[2].point, queue)
+	return 3
+}
+
+// within returns true if the point comes strictly between the end points of the edge of the left event in the order
+// of the sweep, so that the edge can be split at it. An end point snapped on a nearly vertical edge can fall outside.
+func within(p [2]float64, le *sweepEvent) bool {
+	return pointBefore(le.point, p) && pointBefore(p, le.otherEvent.point)
+}
+
+// pointBefore returns true if the sweep line meets p1 before p2.
+func pointBefore(p1 [2]float64, p2 [2]float64) bool {
+	return p1[0] < p2[0] || (p1[0] == p2[0] && p1[1] < p2[1])
+}
+
+// divideSegment splits the edge of the event at the point.
+func divideSegment(se *sweepEvent, p [2]float64, queue *eventQueue) {
+	r := newSweepEvent(p, false, se, se.isSubject)
+	l := newSweepEvent(p, true, se.otherEvent, se.isSubject)
+	r.contourID = se.contourID
+	l.contourID = se.contourID
+
+	// avoid a rounding error which would process the left event after the right one
+	if compareEvents(l, se.otherEvent) > 0 {
+		se.otherEvent.left = true
+		l.left = false
+	}
+
+	se.otherEvent.otherEvent = l
+	se.otherEvent = r
+
+	heap.Push(queue, l)
+	heap.Push(queue, r)
+}
+
+// segmentIntersection returns the points where the segments a1a2 and b1b2 meet, none if they don't intersect,
+// one if they intersect at a point and two, the ends of the overlap, if they overlap.
+func segmentIntersection(a1 [2]float64, a2 [2]float64, b1 [2]float64, b2 [2]float64) [][2]float64 {
+	va := [2]float64{a2[0] - a1[0], a2[1] - a1[1]}
+	vb := [2]float64{b2[0] - b1[0], b2[1] - b1[1]}
+	e := [2]float64{b1[0] - a1[0], b1[1] - a1[1]}
+	toPoint := func(s float64) [2]float64 {
+		return [2]float64{a1[0] + s*va[0], a1[1] + s*va[1]}
+	}
+
+	kross := crossProduct(va, vb)
+	if kross*kross > 0 {
+		// an end point lying on the other segment is the intersection, kept exact so that the other segment is
+		// split at it and not at a point rounded off the segment
+		var ends [][2]float64
+		for _, p := range [][2]float64{a1, a2} {
+			if onSegment(p, b1, b2) {
+				ends = append(ends, p)
+			}
+		}
+		for _, p := range [][2]float64{b1, b2} {
+			if onSegment(p, a1, a2) && (len(ends) == 0 || (p != ends[0] && p != ends[len(ends)-1])) {
+				ends = append(ends, p)
+			}
+		}
+		switch len(ends) {
+		case 0:
+		case 1:
+			return ends
+		default:
+			// two end points on the other segment, the segments are nearly collinear and overlap between them
+			sort.Slice(ends, func(i, j int) bool {
+				return pointBefore(ends[i], ends[j])
+			})
+			return [][2]float64{ends[0], ends[len(ends)-1]}
+		}
+
+		// the lines intersect, check that the intersection is on both segments
+		s := crossProduct(e, vb) / kross
+		if s < 0 || s > 1 {
+			return nil
+		}
+		t := crossProduct(e, va) / kross
+		if t < 0 || t > 1 {
+			return nil
+		}
+		return [][2]float64{toPoint(s)}
+	}
+
+	// the lines are parallel, and they are the same line if e is parallel to them
+	kross = crossProduct(e, va)
+	if kross*kross > 0 {
+		return nil
+	}
+
+	sqrLenA := dotProduct(va, va)
+	sa := dotProduct(va, e) / sqrLenA
+	sb := sa + dotProduct(va, vb)/sqrLenA
+	smin := math.Min(sa, sb)
+	smax := math.Max(sa, sb)
+	if smin <= 1 && smax >= 0 {
+		// overlap on an end point
+		if smin == 1 {
+			return [][2]float64{a2}
+		}
+		if smax == 0 {
+			return [][2]float64{a1}
+		}
+		return [][2]float64{toPoint(math.Max(smin, 0)), toPoint(math.Min(smax, 1))}
+	}
+	return nil
+}
+
+// onSegment returns true if the point is within the snap tolerance from the segment ab.
+func onSegment(p [2]float64, a [2]float64, b [2]float64) bool {
+	v := [2]float64{b[0] - a[0], b[1] - a[1]}
+	t := dotProduct([2]float64{p[0] - a[0], p[1] - a[1]}, v) / dotProduct(v, v)
+	return t >= 0 && t <= 1 && onLine(p, a, b)
+}
+
+// onLine returns true if the point is within the snap tolerance from the line through a and b.
+func onLine(p [2]float64, a [2]float64, b [2]float64) bool {
+	v := [2]float64{b[0] - a[0], b[1] - a[1]}
+	tolerance := snapTolerance * math.Max(1, math.Max(maxAbs(a, b), maxAbs(p, p)))
+	return math.Abs(crossProduct(v, [2]float64{p[0] - a[0], p[1] - a[1]})) <= tolerance*math.Sqrt(dotProduct(v, v))
+}
+
+// maxAbs returns the largest absolute coordinate of the points.
+func maxAbs(a [2]float64, b [2]float64) float64 {
+	return math.Max(math.Max(math.Abs(a[0]), math.Abs(a[1])), math.Max(math.Abs(b[0]), math.Abs(b[1])))
+}
+
+func crossProduct(a [2]float64, b [2]float64) float64 {
+	return a[0]*b[1] - a[1]*b[0]
+}
+
+func dotProduct(a [2]float64, b [2]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1]
+}
+
+// toMultiPolygon returns the rings of a polygon or a multi polygon.
+func toMultiPolygon(t interface{}) (multiPolygon, error) {
+	switch gtp := geometry.Pointer(t).(type) {
+	case *feature.Feature:
+		return toMultiPolygon(&gtp.Geometry)
+	case *geometry.Geometry:
+		o, err := gtp.ToObject()
+		if err != nil {
+			return nil, err
+		}
+		return toMultiPolygon(o)
+	case *geometry.Polygon:
+		return multiPolygon{polygonRings(*gtp)}, nil
+	case *geometry.MultiPolygon:
+		mp := make(multiPolygon, 0, len(gtp.Coordinates))
+		for _, poly := range gtp.Coordinates {
+			mp = append(mp, polygonRings(poly))
+		}
+		return mp, nil
+	}
+	return nil, errors.New("geometry must be a Polygon or a MultiPolygon")
+}
+
+func polygonRings(poly geometry.Polygon) [][][2]float64 {
+	rings := make([][][2]float64, 0, len(poly.Coordinates))
+	for _, r := range poly.Coordinates {
+		ring := make([][2]float64, 0, len(r.Coordinates)+1)
+		for _, p := range r.Coordinates {
+			ring = append(ring, [2]float64{p.Lng, p.Lat})
+		}
+		// close the ring
+		if len(ring) > 0 && ring[0] != ring[len(ring)-1] {
+			ring = append(ring, ring[0])
+		}
+		rings = append(rings, ring)
+	}
+	return rings
+}
+
+// toObject returns the result as a Polygon or a MultiPolygon, or nil if it is empty.
+// Collinear vertices are removed and the outer rings are oriented counterclockwise and the holes clockwise.
+func toObject(mp multiPolygon) (geometry.Object, error) {
+	var polygons []geometry.Polygon
+	for _, rings := range mp {
+		var poly geometry.Polygon
+		for i, r := range rings {
+			ring := cleanRing(r, i == 0)
+			if ring == nil {
+				if i == 0 {
+					break
+				}
+				continue
+			}
+			poly.Coordinates = append(poly.Coordinates, geometry.LineString{Coordinates: ring})
+		}
+		if len(poly.Coordinates) > 0 {
+			polygons = append(polygons, poly)
+		}
+	}
+
+	switch len(polygons) {
+	case 0:
+		return nil, nil
+	case 1:
+		return geometry.NewPolygon(polygons[0].Coordinates)
+	}
+	return geometry.NewMultiPolygon(polygons)
+}
+
+// cleanRing returns the closed ring without repeated and collinear vertices, counterclockwise if ccw is true and
+// clockwise otherwise, or nil if it has no area.
+func cleanRing(ring [][2]float64, ccw bool) []geometry.Point {
+	var pts [][2]float64
+	for _, p := range ring {
+		if len(pts) == 0 || pts[len(pts)-1] != p {
+			pts = append(pts, p)
+		}
+	}
+	if len(pts) > 1 && pts[0] == pts[len(pts)-1] {
+		pts = pts[:len(pts)-1]
+	}
+
+	// remove collinear vertices until none is left
+	for removed := true; removed && len(pts) >= 3; {
+		removed = false
+		for i := 0; i < len(pts) && len(pts) >= 3; i++ {
+			prev := pts[(i+len(pts)-1)%len(pts)]
+			next := pts[(i+1)%len(pts)]
+			if signedArea(prev, pts[i], next) == 0 {
+				pts = append(pts[:i], pts[i+1:]...)
+				removed = true
+				i--
+			}
+		}
+	}
+	if len(pts) < 3 {
+		return nil
+	}
+
+	area := 0.0
+	for i := range pts {
+		area += crossProduct(pts[i], pts[(i+1)%len(pts)])
+	}
+	if (area > 0) != ccw {
+		for i, j := 0, len(pts)-1; i < j; i, j = i+1, j-1 {
+			pts[i], pts[j] = pts[j], pts[i]
+		}
+	}
+
+	coords := make([]geometry.Point, 0, len(pts)+1)
+	for _, p := range pts {
+		coords = append(coords, geometry.Point{Lng: p[0], Lat: p[1]})
+	}
+	return append(coords, coords[0])
+}
@@ -0,0 +1,131 @@
+package transformation
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/booleans"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/utils"
+)
+
+const fixturesDir = "../test-data/transformation"
+
+// runFixtures applies the operation on the first two features of every fixture of the operation and checks that
+// the result equals the third feature, or is empty if the fixture has no third feature, and that its outer rings are
+// counterclockwise and its holes clockwise.
+func runFixtures(t *testing.T, name string, operation func(interface{}, interface{}) (geometry.Object, error)) {
+	dir := filepath.Join(fixturesDir, name)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("cannot read fixtures: %v", err)
+	}
+	if len(files) == 0 {
+		t.Fatalf("no fixtures in %s", dir)
+	}
+	for _, f := range files {
+		t.Run(f.Name(), func(t *testing.T) {
+			gjson, err := utils.LoadJSONFixture(filepath.Join(dir, f.Name()))
+			if err != nil {
+				t.Fatalf("cannot load fixture: %v", err)
+			}
+			fc, err := feature.CollectionFromJSON(gjson)
+			if err != nil {
+				t.Fatalf("cannot decode fixture: %v", err)
+			}
+			got, err := operation(&fc.Features[0], &fc.Features[1])
+			if err != nil {
+				t.Fatalf("%s error: %v", name, err)
+			}
+			if len(fc.Features) < 3 {
+				assert.Equal(t, got, nil)
+				return
+			}
+			want, err := fc.Features[2].Geometry.ToObject()
+			if err != nil {
+				t.Fatalf("cannot decode the expected geometry: %v", err)
+			}
+			eq, err := booleans.Equal(got, want)
+			if err != nil {
+				t.Fatalf("Equal error: %v", err)
+			}
+			if !eq {
+				t.Errorf("%s() = %v, want %v", name, got, want)
+			}
+			checkOrientation(t, got)
+		})
+	}
+}
+
+// checkOrientation checks that the outer rings of the Polygon or MultiPolygon are counterclockwise and its holes
+// clockwise.
+func checkOrientation(t *testing.T, o geometry.Object) {
+	var polygons []geometry.Polygon
+	switch g := o.(type) {
+	case *geometry.Polygon:
+		polygons = []geometry.Polygon{*g}
+	case *geometry.MultiPolygon:
+		polygons = g.Coordinates
+	default:
+		t.Fatalf("got %v, want a Polygon or a MultiPolygon", o)
+	}
+	for _, poly := range polygons {
+		for i, r := range poly.Coordinates {
+			if a := ringArea(r.Coordinates); (i == 0) != (a > 0) {
+				t.Errorf("ring %d %v has the wrong orientation", i, r.Coordinates)
+			}
+		}
+	}
+}
+
+func TestUnion(t *testing.T) {
+	runFixtures(t, "union", Union)
+}
+
+func TestIntersect(t *testing.T) {
+	runFixtures(t, "intersect", Intersect)
+}
+
+func TestDifference(t *testing.T) {
+	runFixtures(t, "difference", Difference)
+}
+
+func TestXor(t *testing.T) {
+	runFixtures(t, "xor", Xor)
+}
+
+func TestClipOrientation(t *testing.T) {
+	// a clockwise square with a counterclockwise hole
+	square := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0, Lat: 4}, {Lng: 4, Lat: 4}, {Lng: 4, Lat: 0}, {Lng: 0, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 1, Lat: 1}, {Lng: 3, Lat: 1}, {Lng: 3, Lat: 3}, {Lng: 1, Lat: 3}, {Lng: 1, Lat: 1}}},
+	}}
+	o, err := Union(&square, &geometry.MultiPolygon{})
+	if err != nil {
+		t.Fatalf("Union error %v", err)
+	}
+	poly, ok := o.(*geometry.Polygon)
+	if !ok {
+		t.Fatalf("Union() = %v, want a Polygon", o)
+	}
+	assert.Equal(t, len(poly.Coordinates), 2)
+	assert.Equal(t, ringArea(poly.Coordinates[0].Coordinates) > 0, true)
+	assert.Equal(t, ringArea(poly.Coordinates[1].Coordinates) < 0, true)
+
+	_, err = Union(&geometry.Point{}, &square)
+	if err == nil {
+		t.Errorf("Union expected an error for a point")
+	}
+}
+
+// ringArea returns twice the signed area of the ring, positive if it is counterclockwise.
+func ringArea(ring []geometry.Point) float64 {
+	area := 0.0
+	for i := 1; i < len(ring); i++ {
+		area += ring[i-1].Lng*ring[i].Lat - ring[i].Lng*ring[i-1].Lat
+	}
+	return area
+}
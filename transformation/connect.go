@@ -0,0 +1,128 @@
+package transformation
+
+import (
+	"math"
+	"sort"
+)
+
+// edge is an edge of the result directed so that the inside of the result is on its left.
+type edge struct {
+	from [2]float64
+	to   [2]float64
+	used bool
+}
+
+// ring is a closed ring of the result with its signed area, positive for outer rings and negative for holes.
+type ring struct {
+	points [][2]float64
+	area   float64
+}
+
+// connectEdges joins the edges in the result into rings and returns the outer rings with their holes.
+// The edges are followed turning as far left as possible, so that the rings touching at a point are kept apart
+// and the outer rings come out counterclockwise and the holes clockwise.
+func connectEdges(sortedEvents []*sweepEvent) multiPolygon {
+	var edges []*edge
+	outgoing := map[[2]float64][]*edge{}
+	for _, e := range sortedEvents {
+		if !e.left || !e.inResult() || e.point == e.otherEvent.point {
+			continue
+		}
+		ed := &edge{from: e.point, to: e.otherEvent.point}
+		// the inside of the result is above an out-in transition
+		if e.resultTransition < 0 {
+			ed.from, ed.to = ed.to, ed.from
+		}
+		edges = append(edges, ed)
+		outgoing[ed.from] = append(outgoing[ed.from], ed)
+	}
+
+	var outers, holes []ring
+	for _, start := range edges {
+		if start.used {
+			continue
+		}
+		points := [][2]float64{start.from}
+		for current := start; ; {
+			current.used = true
+			points = append(points, current.to)
+			next := leftmostEdge(current, outgoing[current.to], start)
+			if next == nil || next == start {
+				break
+			}
+			current = next
+		}
+		if points[len(points)-1] != points[0] {
+			points = append(points, points[0])
+		}
+
+		r := ring{points: points, area: signedRingArea(points)}
+		if r.area > 0 {
+			outers = append(outers, r)
+		} else if r.area < 0 {
+			holes = append(holes, r)
+		}
+	}
+
+	// the holes belong to the smallest outer ring containing them
+	sort.Slice(outers, func(i, j int) bool {
+		return outers[i].area < outers[j].area
+	})
+	result := make(multiPolygon, len(outers))
+	for i, o := range outers {
+		result[i] = [][][2]float64{o.points}
+	}
+	for _, h := range holes {
+		// the middle of an edge of the hole can't lie on another ring
+		p := [2]float64{(h.points[0][0] + h.points[1][0]) / 2, (h.points[0][1] + h.points[1][1]) / 2}
+		for i, o := range outers {
+			if inRing(p, o.points) {
+				result[i] = append(result[i], h.points)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// leftmostEdge returns the unused edge leaving the end of the current edge with the sharpest left turn, or the start
+// edge of the ring if it turns further left.
+func leftmostEdge(current *edge, candidates []*edge, start *edge) *edge {
+	din := [2]float64{current.to[0] - current.from[0], current.to[1] - current.from[1]}
+	var best *edge
+	bestTurn := math.Inf(-1)
+	for _, c := range candidates {
+		if c.used && c != start {
+			continue
+		}
+		dout := [2]float64{c.to[0] - c.from[0], c.to[1] - c.from[1]}
+		turn := math.Atan2(crossProduct(din, dout), dotProduct(din, dout))
+		if turn > bestTurn {
+			best = c
+			bestTurn = turn
+		}
+	}
+	return best
+}
+
+// signedRingArea returns the area of the closed ring, positive if it is counterclockwise.
+func signedRingArea(points [][2]float64) float64 {
+	area := 0.0
+	for i := 1; i < len(points); i++ {
+		area += crossProduct(points[i-1], points[i])
+	}
+	return area / 2
+}
+
+// inRing returns true if the point is inside the closed ring.
+func inRing(p [2]float64, points [][2]float64) bool {
+	inside := false
+	for i := 1; i < len(points); i++ {
+		a := points[i-1]
+		b := points[i]
+		if (a[1] > p[1]) != (b[1] > p[1]) && p[0] < (b[0]-a[0])*(p[1]-a[1])/(b[1]-a[1])+a[0] {
+			inside = !inside
+		}
+	}
+	return inside
+}
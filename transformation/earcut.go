@@ -0,0 +1,584 @@
+package transformation
+
+import (
+	"math"
+	"sort"
+)
+
+// earNode is a vertex of a ring in the circular doubly linked list triangulated by earcut.
+type earNode struct {
+	// i is the index of the vertex in the triangulated points.
+	i    int
+	x    float64
+	y    float64
+	prev *earNode
+	next *earNode
+	// z is the position of the vertex on the z-order curve, prevZ and nextZ link the vertices in that order.
+	z     int
+	prevZ *earNode
+	nextZ *earNode
+	// steiner is true for a hole of a single point.
+	steiner bool
+}
+
+// earcut triangulates the polygon of the flat coordinates, x and y of every point, with the holes starting at the
+// points of holeIndices, and returns the indexes of the points of every triangle, counterclockwise.
+// It is a port of the ear clipping algorithm of mapbox/earcut: the holes are bridged to the outer ring, the ears are
+// cut while they don't contain another vertex, using a z-order curve to find the vertices for large polygons, and
+// the polygon is cured of its self intersections and split in two when no ear is left.
+func earcut(data []float64, holeIndices []int) []int {
+	outerLen := len(data)
+	if len(holeIndices) > 0 {
+		outerLen = holeIndices[0] * 2
+	}
+	outerNode := linkedList(data, 0, outerLen, true)
+	var triangles []int
+	if outerNode == nil || outerNode.next == outerNode.prev {
+		return triangles
+	}
+	if len(holeIndices) > 0 {
+		outerNode = eliminateHoles(data, holeIndices, outerNode)
+	}
+
+	// a z-order curve index of the vertices speeds up large polygons
+	minX, minY, invSize := 0.0, 0.0, 0.0
+	if len(data) > 80*2 {
+		minX, minY = data[0], data[1]
+		maxX, maxY := minX, minY
+		for i := 2; i < outerLen; i += 2 {
+			minX = math.Min(minX, data[i])
+			minY = math.Min(minY, data[i+1])
+			maxX = math.Max(maxX, data[i])
+			maxY = math.Max(maxY, data[i+1])
+		}
+		invSize = math.Max(maxX-minX, maxY-minY)
+		if invSize != 0 {
+			invSize = 32767 / invSize
+		}
+	}
+
+	return earcutLinked(outerNode, triangles, minX, minY, invSize, 0)
+}
+
+// linkedList returns a circular list of the points from start to end, counterclockwise or clockwise.
+func linkedList(data []float64, start int, end int, ccw bool) *earNode {
+	var last *earNode
+	if ccw == (flatArea(data, start, end) > 0) {
+		for i := start; i < end; i += 2 {
+			last = insertNode(i/2, data[i], data[i+1], last)
+		}
+	} else {
+		for i := end - 2; i >= start; i -= 2 {
+			last = insertNode(i/2, data[i], data[i+1], last)
+		}
+	}
+	if last != nil && equalNodes(last, last.next) {
+		removeNode(last)
+		last = last.next
+	}
+	return last
+}
+
+// filterPoints removes the repeated and collinear points between start and end.
+func filterPoints(start *earNode, end *earNode) *earNode {
+	if start == nil {
+		return start
+	}
+	if end == nil {
+		end = start
+	}
+	p := start
+	for {
+		again := false
+		if !p.steiner && (equalNodes(p, p.next) || nodeArea(p.prev, p, p.next) == 0) {
+			removeNode(p)
+			p = p.prev
+			end = p
+			if p == p.next {
+				break
+			}
+			again = true
+		} else {
+			p = p.next
+		}
+		if !again && p == end {
+			break
+		}
+	}
+	return end
+}
+
+// earcutLinked cuts the ears of the polygon, and when none is left filters its points, then cures its self
+// intersections, then splits it.
+func earcutLinked(ear *earNode, triangles []int, minX float64, minY float64, invSize float64, pass int) []int {
+	if ear == nil {
+		return triangles
+	}
+	if pass == 0 && invSize != 0 {
+		indexCurve(ear, minX, minY, invSize)
+	}
+
+	stop := ear
+	for ear.prev != ear.next {
+		prev := ear.prev
+		next := ear.next
+
+		isEar := false
+		if invSize != 0 {
+			isEar = isEarHashed(ear, minX, minY, invSize)
+		} else {
+			isEar = isEarNode(ear)
+		}
+		if isEar {
+			triangles = append(triangles, prev.i, ear.i, next.i)
+			removeNode(ear)
+			// skipping the next vertex leads to less sliver triangles
+			ear = next.next
+			stop = next.next
+			continue
+		}
+
+		ear = next
+		if ear == stop {
+			switch pass {
+			case 0:
+				triangles = earcutLinked(filterPoints(ear, nil), triangles, minX, minY, invSize, 1)
+			case 1:
+				ear, triangles = cureLocalIntersections(filterPoints(ear, nil), triangles)
+				triangles = earcutLinked(ear, triangles, minX, minY, invSize, 2)
+			case 2:
+				triangles = splitEarcut(ear, triangles, minX, minY, invSize)
+			}
+			return triangles
+		}
+	}
+	return triangles
+}
+
+// isEarNode returns true if the vertex is convex and its triangle with its neighbours contains no other vertex.
+func isEarNode(ear *earNode) bool {
+	a, b, c := ear.prev, ear, ear.next
+	if nodeArea(a, b, c) >= 0 {
+		// reflex
+		return false
+	}
+	x0, y0, x1, y1 := triangleBox(a, b, c)
+	for p := c.next; p != a; p = p.next {
+		if p.x >= x0 && p.x <= x1 && p.y >= y0 && p.y <= y1 &&
+			pointInTriangle(a.x, a.y, b.x, b.y, c.x, c.y, p.x, p.y) && nodeArea(p.prev, p, p.next) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isEarHashed is isEarNode looking only at the vertices in the range of the z-order curve of the triangle.
+func isEarHashed(ear *earNode, minX float64, minY float64, invSize float64) bool {
+	a, b, c := ear.prev, ear, ear.next
+	if nodeArea(a, b, c) >= 0 {
+		return false
+	}
+	x0, y0, x1, y1 := triangleBox(a, b, c)
+	minZ := zOrder(x0, y0, minX, minY, invSize)
+	maxZ := zOrder(x1, y1, minX, minY, invSize)
+
+	inside := func(p *earNode) bool {
+		return p.x >= x0 && p.x <= x1 && p.y >= y0 && p.y <= y1 && p != a && p != c &&
+			pointInTriangle(a.x, a.y, b.x, b.y, c.x, c.y, p.x, p.y) && nodeArea(p.prev, p, p.next) >= 0
+	}
+	p := ear.prevZ
+	n := ear.nextZ
+	for p != nil && p.z >= minZ && n != nil && n.z <= maxZ {
+		if inside(p) || inside(n) {
+			return false
+		}
+		p = p.prevZ
+		n = n.nextZ
+	}
+	for ; p != nil && p.z >= minZ; p = p.prevZ {
+		if inside(p) {
+			return false
+		}
+	}
+	for ; n != nil && n.z <= maxZ; n = n.nextZ {
+		if inside(n) {
+			return false
+		}
+	}
+	return true
+}
+
+// cureLocalIntersections cuts the triangles of the small self intersections of the polygon.
+func cureLocalIntersections(start *earNode, triangles []int) (*earNode, []int) {
+	p := start
+	for {
+		a := p.prev
+		b := p.next.next
+		if !equalNodes(a, b) && segmentsIntersect(a, p, p.next, b) && locallyInside(a, b) && locallyInside(b, a) {
+			triangles = append(triangles, a.i, p.i, b.i)
+			removeNode(p)
+			removeNode(p.next)
+			p = b
+			start = b
+		}
+		p = p.next
+		if p == start {
+			break
+		}
+	}
+	return filterPoints(p, nil), triangles
+}
+
+// splitEarcut splits the polygon in two along a valid diagonal and triangulates both parts.
+func splitEarcut(start *earNode, triangles []int, minX float64, minY float64, invSize float64) []int {
+	a := start
+	for {
+		for b := a.next.next; b != a.prev; b = b.next {
+			if a.i != b.i && isValidDiagonal(a, b) {
+				c := splitPolygon(a, b)
+				a = filterPoints(a, a.next)
+				c = filterPoints(c, c.next)
+				triangles = earcutLinked(a, triangles, minX, minY, invSize, 0)
+				return earcutLinked(c, triangles, minX, minY, invSize, 0)
+			}
+		}
+		a = a.next
+		if a == start {
+			return triangles
+		}
+	}
+}
+
+// eliminateHoles bridges the holes to the outer ring, from the leftmost one to the rightmost one.
+func eliminateHoles(data []float64, holeIndices []int, outerNode *earNode) *earNode {
+	var queue []*earNode
+	for i, h := range holeIndices {
+		end := len(data)
+		if i < len(holeIndices)-1 {
+			end = holeIndices[i+1] * 2
+		}
+		list := linkedList(data, h*2, end, false)
+		if list == nil {
+			continue
+		}
+		if list == list.next {
+			list.steiner = true
+		}
+		queue = append(queue, leftmostNode(list))
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		return queue[i].x < queue[j].x
+	})
+	for _, h := range queue {
+		outerNode = eliminateHole(h, outerNode)
+	}
+	return outerNode
+}
+
+// eliminateHole joins the hole to the outer ring by a bridge from its leftmost vertex.
+func eliminateHole(hole *earNode, outerNode *earNode) *earNode {
+	bridge := findHoleBridge(hole, outerNode)
+	if bridge == nil {
+		return outerNode
+	}
+	bridgeReverse := splitPolygon(bridge, hole)
+	// the collinear points around the cuts
+	filteredBridge := filterPoints(bridge, bridge.next)
+	filterPoints(bridgeReverse, bridgeReverse.next)
+	if outerNode == bridge {
+		return filteredBridge
+	}
+	return outerNode
+}
+
+// findHoleBridge returns the vertex of the outer ring seen by the leftmost vertex of the hole, found by casting a
+// ray to the left of it.
+func findHoleBridge(hole *earNode, outerNode *earNode) *earNode {
+	hx, hy := hole.x, hole.y
+	qx := math.Inf(-1)
+	var m *earNode
+
+	// the closest segment on the left of the hole, with the end point of the segment having the smaller x
+	p := outerNode
+	for {
+		if hy <= p.y && hy >= p.next.y && p.next.y != p.y {
+			x := p.x + (hy-p.y)*(p.next.x-p.x)/(p.next.y-p.y)
+			if x <= hx && x > qx {
+				qx = x
+				if x == hx {
+					if hy == p.y {
+						return p
+					}
+					if hy == p.next.y {
+						return p.next
+					}
+				}
+				if p.x < p.next.x {
+					m = p
+				} else {
+					m = p.next
+				}
+			}
+		}
+		p = p.next
+		if p == outerNode {
+			break
+		}
+	}
+	if m == nil {
+		return nil
+	}
+	if hx == qx {
+		return m
+	}
+
+	// the vertices inside the triangle of the hole point, the segment intersection and its end point can be seen
+	// instead, the one with the smallest angle to the ray is the bridge
+	stop := m
+	mx, my := m.x, m.y
+	tanMin := math.Inf(1)
+	p = m
+	for {
+		ax, cx := qx, hx
+		if hy < my {
+			ax, cx = hx, qx
+		}
+		if hx >= p.x && p.x >= mx && hx != p.x && pointInTriangle(ax, hy, mx, my, cx, hy, p.x, p.y) {
+			tan := math.Abs(hy-p.y) / (hx - p.x)
+			if locallyInside(p, hole) &&
+				(tan < tanMin || (tan == tanMin && (p.x > m.x || (p.x == m.x && sectorContainsSector(m, p))))) {
+				m = p
+				tanMin = tan
+			}
+		}
+		p = p.next
+		if p == stop {
+			break
+		}
+	}
+	return m
+}
+
+// sectorContainsSector returns true if the sector of the vertex p is inside the sector of the vertex m.
+func sectorContainsSector(m *earNode, p *earNode) bool {
+	return nodeArea(m.prev, m, p.prev) < 0 && nodeArea(p.next, m, m.next) < 0
+}
+
+// indexCurve sorts the vertices by their position on the z-order curve.
+func indexCurve(start *earNode, minX float64, minY float64, invSize float64) {
+	var nodes []*earNode
+	p := start
+	for {
+		p.z = zOrder(p.x, p.y, minX, minY, invSize)
+		nodes = append(nodes, p)
+		p = p.next
+		if p == start {
+			break
+		}
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].z < nodes[j].z
+	})
+	for i, n := range nodes {
+		n.prevZ = nil
+		n.nextZ = nil
+		if i > 0 {
+			n.prevZ = nodes[i-1]
+		}
+		if i < len(nodes)-1 {
+			n.nextZ = nodes[i+1]
+		}
+	}
+}
+
+// zOrder returns the position of the point on the z-order curve, interleaving the bits of its coordinates scaled to
+// 15 bits.
+func zOrder(x float64, y float64, minX float64, minY float64, invSize float64) int {
+	ix := int((x - minX) * invSize)
+	iy := int((y - minY) * invSize)
+
+	ix = (ix | (ix << 8)) & 0x00FF00FF
+	ix = (ix | (ix << 4)) & 0x0F0F0F0F
+	ix = (ix | (ix << 2)) & 0x33333333
+	ix = (ix | (ix << 1)) & 0x55555555
+
+	iy = (iy | (iy << 8)) & 0x00FF00FF
+	iy = (iy | (iy << 4)) & 0x0F0F0F0F
+	iy = (iy | (iy << 2)) & 0x33333333
+	iy = (iy | (iy << 1)) & 0x55555555
+
+	return ix | (iy << 1)
+}
+
+// leftmostNode returns the vertex of the ring with the smallest x, and the smallest y among them.
+func leftmostNode(start *earNode) *earNode {
+	leftmost := start
+	for p := start.next; p != start; p = p.next {
+		if p.x < leftmost.x || (p.x == leftmost.x && p.y < leftmost.y) {
+			leftmost = p
+		}
+	}
+	return leftmost
+}
+
+// pointInTriangle returns true if the point p lies inside the triangle abc or on its sides.
+func pointInTriangle(ax float64, ay float64, bx float64, by float64, cx float64, cy float64, px float64, py float64) bool {
+	return (cx-px)*(ay-py) >= (ax-px)*(cy-py) &&
+		(ax-px)*(by-py) >= (bx-px)*(ay-py) &&
+		(bx-px)*(cy-py) >= (cx-px)*(by-py)
+}
+
+// isValidDiagonal returns true if the diagonal from a to b lies inside the polygon without crossing it.
+func isValidDiagonal(a *earNode, b *earNode) bool {
+	if a.next.i == b.i || a.prev.i == b.i || intersectsPolygon(a, b) {
+		return false
+	}
+	if locallyInside(a, b) && locallyInside(b, a) && middleInside(a, b) &&
+		(nodeArea(a.prev, a, b.prev) != 0 || nodeArea(a, b.prev, b) != 0) {
+		return true
+	}
+	// a zero length diagonal between two convex vertices
+	return equalNodes(a, b) && nodeArea(a.prev, a, a.next) > 0 && nodeArea(b.prev, b, b.next) > 0
+}
+
+// nodeArea returns twice the signed area of the triangle, negative if it is counterclockwise.
+func nodeArea(p *earNode, q *earNode, r *earNode) float64 {
+	return (q.y-p.y)*(r.x-q.x) - (q.x-p.x)*(r.y-q.y)
+}
+
+func equalNodes(p1 *earNode, p2 *earNode) bool {
+	return p1.x == p2.x && p1.y == p2.y
+}
+
+// segmentsIntersect returns true if the segment from p1 to q1 intersects the segment from p2 to q2.
+func segmentsIntersect(p1 *earNode, q1 *earNode, p2 *earNode, q2 *earNode) bool {
+	o1 := sign(nodeArea(p1, q1, p2))
+	o2 := sign(nodeArea(p1, q1, q2))
+	o3 := sign(nodeArea(p2, q2, p1))
+	o4 := sign(nodeArea(p2, q2, q1))
+
+	if o1 != o2 && o3 != o4 {
+		return true
+	}
+	// collinear points lying on the other segment
+	return (o1 == 0 && inBox(p1, p2, q1)) || (o2 == 0 && inBox(p1, q2, q1)) ||
+		(o3 == 0 && inBox(p2, p1, q2)) || (o4 == 0 && inBox(p2, q1, q2))
+}
+
+// inBox returns true if the point q lies in the bounding box of the segment from p to r.
+func inBox(p *earNode, q *earNode, r *earNode) bool {
+	return q.x <= math.Max(p.x, r.x) && q.x >= math.Min(p.x, r.x) && q.y <= math.Max(p.y, r.y) && q.y >= math.Min(p.y, r.y)
+}
+
+func sign(v float64) int {
+	if v > 0 {
+		return 1
+	}
+	if v < 0 {
+		return -1
+	}
+	return 0
+}
+
+// intersectsPolygon returns true if the diagonal from a to b crosses an edge of the polygon.
+func intersectsPolygon(a *earNode, b *earNode) bool {
+	p := a
+	for {
+		if p.i != a.i && p.next.i != a.i && p.i != b.i && p.next.i != b.i && segmentsIntersect(p, p.next, a, b) {
+			return true
+		}
+		p = p.next
+		if p == a {
+			return false
+		}
+	}
+}
+
+// locallyInside returns true if the diagonal from a to b starts inside the polygon at a.
+func locallyInside(a *earNode, b *earNode) bool {
+	if nodeArea(a.prev, a, a.next) < 0 {
+		return nodeArea(a, b, a.next) >= 0 && nodeArea(a, a.prev, b) >= 0
+	}
+	return nodeArea(a, b, a.prev) < 0 || nodeArea(a, a.next, b) < 0
+}
+
+// middleInside returns true if the middle of the diagonal from a to b is inside the polygon.
+func middleInside(a *earNode, b *earNode) bool {
+	inside := false
+	px := (a.x + b.x) / 2
+	py := (a.y + b.y) / 2
+	p := a
+	for {
+		if (p.y > py) != (p.next.y > py) && p.next.y != p.y && px < (p.next.x-p.x)*(py-p.y)/(p.next.y-p.y)+p.x {
+			inside = !inside
+		}
+		p = p.next
+		if p == a {
+			return inside
+		}
+	}
+}
+
+// splitPolygon links the vertex a to the vertex b with two copies of the diagonal, splitting the polygon in two, and
+// returns the copy of b in the second polygon. If a and b belong to different rings they are merged into one.
+func splitPolygon(a *earNode, b *earNode) *earNode {
+	a2 := &earNode{i: a.i, x: a.x, y: a.y}
+	b2 := &earNode{i: b.i, x: b.x, y: b.y}
+	an := a.next
+	bp := b.prev
+
+	a.next = b
+	b.prev = a
+
+	a2.next = an
+	an.prev = a2
+
+	b2.next = a2
+	a2.prev = b2
+
+	bp.next = b2
+	b2.prev = bp
+
+	return b2
+}
+
+// insertNode creates a vertex after the last one.
+func insertNode(i int, x float64, y float64, last *earNode) *earNode {
+	p := &earNode{i: i, x: x, y: y}
+	if last == nil {
+		p.prev = p
+		p.next = p
+	} else {
+		p.next = last.next
+		p.prev = last
+		last.next.prev = p
+		last.next = p
+	}
+	return p
+}
+
+func removeNode(p *earNode) {
+	p.next.prev = p.prev
+	p.prev.next = p.next
+	if p.prevZ != nil {
+		p.prevZ.nextZ = p.nextZ
+	}
+	if p.nextZ != nil {
+		p.nextZ.prevZ = p.prevZ
+	}
+}
+
+// triangleBox returns the bounding box of the triangle.
+func triangleBox(a *earNode, b *earNode, c *earNode) (float64, float64, float64, float64) {
+	return math.Min(a.x, math.Min(b.x, c.x)), math.Min(a.y, math.Min(b.y, c.y)),
+		math.Max(a.x, math.Max(b.x, c.x)), math.Max(a.y, math.Max(b.y, c.y))
+}
+
+// flatArea returns twice the signed area of the ring of the flat coordinates, positive if it is counterclockwise.
+func flatArea(data []float64, start int, end int) float64 {
+	sum := 0.0
+	for i, j := start, end-2; i < end; i, j = i+2, i {
+		sum += (data[j] - data[i]) * (data[i+1] + data[j+1])
+	}
+	return sum
+}
@@ -0,0 +1,125 @@
+package transformation
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/tomchavakis/turf-go/delaunay"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/measurement"
+	"github.com/tomchavakis/turf-go/meta/coordAll"
+)
+
+// Convex returns the convex hull of the points, computed with the monotone chain algorithm in the plane of their
+// longitudes and latitudes, as a Polygon with a counterclockwise ring.
+// The points on the sides of the hull aren't vertices of it.
+// t can be a []geometry.Point, a feature.Collection of points, or any GeoJSON object accepted by meta.CoordAll whose
+// coordinates are the points.
+func Convex(t interface{}) (*geometry.Polygon, error) {
+	points, err := hullPoints(t)
+	if err != nil {
+		return nil, err
+	}
+	sorted := append([]geometry.Point{}, points...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Lng != sorted[j].Lng {
+			return sorted[i].Lng < sorted[j].Lng
+		}
+		return sorted[i].Lat < sorted[j].Lat
+	})
+
+	// the lower hull from left to right, then the upper hull back to the first point
+	hull := make([]geometry.Point, 0, len(sorted)+1)
+	for _, p := range sorted {
+		for len(hull) >= 2 && turn(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	lower := len(hull) + 1
+	for i := len(sorted) - 2; i >= 0; i-- {
+		p := sorted[i]
+		for len(hull) >= lower && turn(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	if len(hull) < 4 {
+		return nil, errors.New("the points don't enclose an area")
+	}
+	return geometry.NewPolygon([]geometry.LineString{{Coordinates: hull}})
+}
+
+// Concave returns the concave hull of the points, the union of the triangles of their Delaunay triangulation whose
+// edges are all shorter than maxEdge in the units, as a Polygon which can have holes.
+// It returns an error if no triangle is kept or if the kept triangles form several polygons, in which case maxEdge
+// should be increased. t can be any input of Convex.
+func Concave(t interface{}, maxEdge float64, units string) (*geometry.Polygon, error) {
+	points, err := hullPoints(t)
+	if err != nil {
+		return nil, err
+	}
+	triangles, err := delaunay.Triangulate(points)
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []multiPolygon
+	for _, t := range triangles {
+		a, b, c := points[t[0]], points[t[1]], points[t[2]]
+		short := true
+		for _, e := range [][2]geometry.Point{{a, b}, {b, c}, {c, a}} {
+			d, err := measurement.PointDistance(e[0], e[1], units)
+			if err != nil {
+				return nil, err
+			}
+			if d > maxEdge {
+				short = false
+				break
+			}
+		}
+		if short {
+			ring := [][2]float64{{a.Lng, a.Lat}, {b.Lng, b.Lat}, {c.Lng, c.Lat}, {a.Lng, a.Lat}}
+			parts = append(parts, multiPolygon{{ring}})
+		}
+	}
+	if len(parts) == 0 {
+		return nil, errors.New("no triangle has all its edges shorter than maxEdge")
+	}
+
+	o, err := toObject(dissolve(parts))
+	if err != nil {
+		return nil, err
+	}
+	poly, ok := o.(*geometry.Polygon)
+	if !ok {
+		return nil, errors.New("the concave hull is made of several polygons")
+	}
+	return poly, nil
+}
+
+// hullPoints returns the points of the input of Convex.
+func hullPoints(t interface{}) ([]geometry.Point, error) {
+	switch gtp := t.(type) {
+	case []geometry.Point:
+		return gtp, nil
+	case feature.Collection:
+		return hullPoints(&gtp)
+	}
+	excludeWrapCoord := true
+	points, err := meta.CoordAll(t, &excludeWrapCoord)
+	if err != nil {
+		return nil, err
+	}
+	if points == nil {
+		return nil, errors.New("unknown geometry type")
+	}
+	return points, nil
+}
+
+// turn returns twice the signed area of the triangle, positive if it turns left from a to b to c.
+func turn(a geometry.Point, b geometry.Point, c geometry.Point) float64 {
+	return (b.Lng-a.Lng)*(c.Lat-a.Lat) - (b.Lat-a.Lat)*(c.Lng-a.Lng)
+}
@@ -0,0 +1,130 @@
+package transformation
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+func TestConvex(t *testing.T) {
+	tests := map[string]struct {
+		points interface{}
+		want   []geometry.Point
+	}{
+		"square": {
+			points: []geometry.Point{
+				{Lng: 1, Lat: 1}, {Lng: 2, Lat: 2}, {Lng: 0, Lat: 0}, {Lng: 0, Lat: 2}, {Lng: 1, Lat: 0}, {Lng: 2, Lat: 0},
+			},
+			want: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: 2}, {Lng: 0, Lat: 2}, {Lng: 0, Lat: 0}},
+		},
+		"repeated points": {
+			points: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}},
+			want:   []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}},
+		},
+		"feature collection": {
+			points: pointCollection(t, `{"type":"FeatureCollection","features":[
+				{"type":"Feature","properties":{},"geometry":{"type":"Point","coordinates":[0,0]}},
+				{"type":"Feature","properties":{},"geometry":{"type":"Point","coordinates":[1,0]}},
+				{"type":"Feature","properties":{},"geometry":{"type":"Point","coordinates":[0.5,0.2]}},
+				{"type":"Feature","properties":{},"geometry":{"type":"Point","coordinates":[1,1]}}
+			]}`),
+			want: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}},
+		},
+		"multi point": {
+			points: &geometry.MultiPoint{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 1, Lat: 0}}},
+			want:   []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			poly, err := Convex(tt.points)
+			if err != nil {
+				t.Fatalf("Convex error %v", err)
+			}
+			if !reflect.DeepEqual(poly.Coordinates[0].Coordinates, tt.want) {
+				t.Errorf("Convex() = %v, want %v", poly.Coordinates[0].Coordinates, tt.want)
+			}
+		})
+	}
+
+	_, err := Convex([]geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 2, Lat: 2}})
+	if err == nil {
+		t.Errorf("Convex expected an error for collinear points")
+	}
+	_, err = Convex("points")
+	if err == nil {
+		t.Errorf("Convex expected an error for an unknown type")
+	}
+}
+
+func pointCollection(t *testing.T, gjson string) feature.Collection {
+	fc, err := feature.CollectionFromJSON(gjson)
+	if err != nil {
+		t.Fatalf("CollectionFromJSON error %v", err)
+	}
+	return *fc
+}
+
+func TestConcave(t *testing.T) {
+	// an L shaped grid of points 0.01 degrees apart, without the top right corner
+	var points []geometry.Point
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 5; j++ {
+			if i < 3 || j < 3 {
+				points = append(points, geometry.Point{Lng: 23.7 + float64(i)/100, Lat: 37.9 + float64(j)/100})
+			}
+		}
+	}
+
+	poly, err := Concave(points, 1.6, constants.UnitKilometers)
+	if err != nil {
+		t.Fatalf("Concave error %v", err)
+	}
+	// the diagonals of the cells are kept, the edges across the corner aren't
+	want := 12.5 * 0.0001
+	if got := ringArea(poly.Coordinates[0].Coordinates) / 2; math.Abs(got-want) > 1e-12 {
+		t.Errorf("area = %v, want %v", got, want)
+	}
+	convex, err := Convex(points)
+	if err != nil {
+		t.Fatalf("Convex error %v", err)
+	}
+	if got := ringArea(convex.Coordinates[0].Coordinates) / 2; math.Abs(got-14*0.0001) > 1e-12 {
+		t.Errorf("convex area = %v, want %v", got, 14*0.0001)
+	}
+
+	// the same points as a feature collection
+	var features []feature.Feature
+	for _, p := range points {
+		g, err := geometry.NewGeometry(&p)
+		if err != nil {
+			t.Fatalf("NewGeometry error %v", err)
+		}
+		features = append(features, feature.Feature{Geometry: *g})
+	}
+	fromCollection, err := Concave(&feature.Collection{Features: features}, 1.6, constants.UnitKilometers)
+	if err != nil {
+		t.Fatalf("Concave error %v", err)
+	}
+	if !reflect.DeepEqual(fromCollection, poly) {
+		t.Errorf("Concave() = %v, want %v", fromCollection, poly)
+	}
+
+	_, err = Concave(points, 0.5, constants.UnitKilometers)
+	if err == nil {
+		t.Errorf("Concave expected an error when no triangle is kept")
+	}
+	far := append([]geometry.Point{{Lng: 25, Lat: 37.9}, {Lng: 25.01, Lat: 37.9}, {Lng: 25, Lat: 37.91}}, points...)
+	_, err = Concave(far, 1.6, constants.UnitKilometers)
+	if err == nil {
+		t.Errorf("Concave expected an error for two clusters")
+	}
+	_, err = Concave(points, 1.6, "parsecs")
+	if err == nil {
+		t.Errorf("Concave expected an error for invalid units")
+	}
+}
@@ -0,0 +1,299 @@
+package transformation
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// minTolerance is the tolerance under which a ring collapsing to less than 4 positions is no longer simplified,
+// the machine epsilon as in Turf.
+const minTolerance = 2.220446049250313e-16
+
+// simplifier returns the points of a line kept with the tolerance, always keeping its end points.
+type simplifier func(points []geometry.Point, tolerance float64) []geometry.Point
+
+// Simplify reduces the number of vertices of a geometry with the Ramer-Douglas-Peucker algorithm, like Turf and
+// simplify-js. The tolerance is a distance in degrees, and unless highQuality is true the points closer than it to
+// the previous kept point are dropped first, which is faster but coarser.
+// The rings of the polygons are kept closed with at least 4 positions by lowering the tolerance for them if needed.
+// t can be any typed geometry.Object, a *geometry.Geometry or a *feature.Feature, and the result has the same type
+// as the geometry.
+func Simplify(t interface{}, tolerance float64, highQuality bool) (geometry.Object, error) {
+	if tolerance < 0 {
+		return nil, errors.New("tolerance must not be negative")
+	}
+	return simplifyObject(t, tolerance, func(points []geometry.Point, tolerance float64) []geometry.Point {
+		if len(points) <= 2 {
+			return points
+		}
+		sqTolerance := tolerance * tolerance
+		if !highQuality {
+			points = simplifyRadialDistance(points, sqTolerance)
+		}
+		return simplifyDouglasPeucker(points, sqTolerance)
+	})
+}
+
+// SimplifyVisvalingam reduces the number of vertices of a geometry with the Visvalingam-Whyatt algorithm, removing
+// the vertices whose triangle with their neighbours has an area, in square degrees, smaller than the threshold.
+// The rings of the polygons are kept closed with at least 4 positions by lowering the threshold for them if needed.
+// t can be any typed geometry.Object, a *geometry.Geometry or a *feature.Feature, and the result has the same type
+// as the geometry.
+func SimplifyVisvalingam(t interface{}, area float64) (geometry.Object, error) {
+	if area < 0 {
+		return nil, errors.New("area must not be negative")
+	}
+	return simplifyObject(t, area, simplifyVisvalingam)
+}
+
+func simplifyObject(t interface{}, tolerance float64, s simplifier) (geometry.Object, error) {
+	switch gtp := geometry.Pointer(t).(type) {
+	case *feature.Feature:
+		return simplifyObject(&gtp.Geometry, tolerance, s)
+	case *geometry.Geometry:
+		o, err := gtp.ToObject()
+		if err != nil {
+			return nil, err
+		}
+		return simplifyObject(o, tolerance, s)
+	case *geometry.Point:
+		p := *gtp
+		return &p, nil
+	case *geometry.MultiPoint:
+		return &geometry.MultiPoint{Coordinates: append([]geometry.Point{}, gtp.Coordinates...)}, nil
+	case *geometry.LineString:
+		return &geometry.LineString{Coordinates: s(gtp.Coordinates, tolerance)}, nil
+	case *geometry.MultiLineString:
+		lines := make([]geometry.LineString, len(gtp.Coordinates))
+		for i, ln := range gtp.Coordinates {
+			lines[i] = geometry.LineString{Coordinates: s(ln.Coordinates, tolerance)}
+		}
+		return &geometry.MultiLineString{Coordinates: lines}, nil
+	case *geometry.Polygon:
+		poly := simplifyPolygon(*gtp, tolerance, s)
+		return &poly, nil
+	case *geometry.MultiPolygon:
+		polys := make([]geometry.Polygon, len(gtp.Coordinates))
+		for i, poly := range gtp.Coordinates {
+			polys[i] = simplifyPolygon(poly, tolerance, s)
+		}
+		return &geometry.MultiPolygon{Coordinates: polys}, nil
+	case *geometry.Collection:
+		geometries := make([]geometry.Geometry, len(gtp.Geometries))
+		for i := range gtp.Geometries {
+			o, err := simplifyObject(&gtp.Geometries[i], tolerance, s)
+			if err != nil {
+				return nil, err
+			}
+			g, err := geometry.NewGeometry(o)
+			if err != nil {
+				return nil, err
+			}
+			geometries[i] = *g
+		}
+		return &geometry.Collection{Geometries: geometries}, nil
+	}
+	return nil, errors.New("unknown geometry type")
+}
+
+func simplifyPolygon(poly geometry.Polygon, tolerance float64, s simplifier) geometry.Polygon {
+	rings := make([]geometry.LineString, len(poly.Coordinates))
+	for i, r := range poly.Coordinates {
+		rings[i] = geometry.LineString{Coordinates: simplifyRing(r.Coordinates, tolerance, s)}
+	}
+	return geometry.Polygon{Coordinates: rings}
+}
+
+// simplifyRing simplifies the closed ring, lowering the tolerance by 1% until it keeps at least 4 positions.
+func simplifyRing(ring []geometry.Point, tolerance float64, s simplifier) []geometry.Point {
+	if len(ring) < 4 {
+		return ring
+	}
+	simple := s(ring, tolerance)
+	for len(simple) < 4 && tolerance >= minTolerance {
+		tolerance -= tolerance * 0.01
+		simple = s(ring, tolerance)
+	}
+	// a degenerate ring collapses with any tolerance
+	if len(simple) < 4 {
+		return ring
+	}
+	first := simple[0]
+	last := simple[len(simple)-1]
+	if first.Lng != last.Lng || first.Lat != last.Lat {
+		simple = append(simple, first)
+	}
+	return simple
+}
+
+// simplifyRadialDistance drops the points closer than the tolerance to the previous kept point.
+func simplifyRadialDistance(points []geometry.Point, sqTolerance float64) []geometry.Point {
+	prev := 0
+	simple := []geometry.Point{points[0]}
+	for i := 1; i < len(points); i++ {
+		if sqDistance(points[i], points[prev]) > sqTolerance {
+			simple = append(simple, points[i])
+			prev = i
+		}
+	}
+	if prev != len(points)-1 {
+		simple = append(simple, points[len(points)-1])
+	}
+	return simple
+}
+
+// simplifyDouglasPeucker keeps the end points of the line and, recursively, the point farthest from the segment
+// between the kept points while it is farther than the tolerance.
+func simplifyDouglasPeucker(points []geometry.Point, sqTolerance float64) []geometry.Point {
+	last := len(points) - 1
+	simple := []geometry.Point{points[0]}
+	simple = douglasPeuckerStep(points, 0, last, sqTolerance, simple)
+	return append(simple, points[last])
+}
+
+func douglasPeuckerStep(points []geometry.Point, first int, last int, sqTolerance float64, simple []geometry.Point) []geometry.Point {
+	maxSqDist := sqTolerance
+	index := -1
+	for i := first + 1; i < last; i++ {
+		d := sqSegmentDistance(points[i], points[first], points[last])
+		if d > maxSqDist {
+			index = i
+			maxSqDist = d
+		}
+	}
+	if index == -1 {
+		return simple
+	}
+	if index-first > 1 {
+		simple = douglasPeuckerStep(points, first, index, sqTolerance, simple)
+	}
+	simple = append(simple, points[index])
+	if last-index > 1 {
+		simple = douglasPeuckerStep(points, index, last, sqTolerance, simple)
+	}
+	return simple
+}
+
+// sqDistance returns the square of the planar distance between the points.
+func sqDistance(p1 geometry.Point, p2 geometry.Point) float64 {
+	dx := p1.Lng - p2.Lng
+	dy := p1.Lat - p2.Lat
+	return dx*dx + dy*dy
+}
+
+// sqSegmentDistance returns the square of the planar distance from the point to the segment.
+func sqSegmentDistance(p geometry.Point, a geometry.Point, b geometry.Point) float64 {
+	x := a.Lng
+	y := a.Lat
+	dx := b.Lng - x
+	dy := b.Lat - y
+	if dx != 0 || dy != 0 {
+		t := ((p.Lng-x)*dx + (p.Lat-y)*dy) / (dx*dx + dy*dy)
+		if t > 1 {
+			x = b.Lng
+			y = b.Lat
+		} else if t > 0 {
+			x += dx * t
+			y += dy * t
+		}
+	}
+	dx = p.Lng - x
+	dy = p.Lat - y
+	return dx*dx + dy*dy
+}
+
+// vertex is an inner point of a line simplified with the Visvalingam-Whyatt algorithm.
+type vertex struct {
+	index int
+	// prev and next are the indexes of the neighbouring points still in the line.
+	prev int
+	next int
+	// area is the area of the triangle of the point with its neighbours.
+	area float64
+	// pos is the position of the vertex in the heap.
+	pos     int
+	removed bool
+}
+
+// vertexHeap is a priority queue of the vertices ordered by area, it implements heap.Interface.
+type vertexHeap []*vertex
+
+func (h vertexHeap) Len() int {
+	return len(h)
+}
+
+func (h vertexHeap) Less(i, j int) bool {
+	return h[i].area < h[j].area
+}
+
+func (h vertexHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].pos = i
+	h[j].pos = j
+}
+
+func (h *vertexHeap) Push(x interface{}) {
+	v := x.(*vertex)
+	v.pos = len(*h)
+	*h = append(*h, v)
+}
+
+func (h *vertexHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	v := old[n-1]
+	*h = old[:n-1]
+	return v
+}
+
+// simplifyVisvalingam removes the point with the smallest triangle with its neighbours, and updates the triangles of
+// the neighbours, while that area is smaller than the threshold.
+func simplifyVisvalingam(points []geometry.Point, area float64) []geometry.Point {
+	if len(points) <= 2 {
+		return points
+	}
+	triangle := func(v *vertex) float64 {
+		a := points[v.prev]
+		b := points[v.index]
+		c := points[v.next]
+		return math.Abs((b.Lng-a.Lng)*(c.Lat-a.Lat)-(c.Lng-a.Lng)*(b.Lat-a.Lat)) / 2
+	}
+
+	vertices := make([]*vertex, len(points))
+	h := make(vertexHeap, 0, len(points)-2)
+	for i := 1; i < len(points)-1; i++ {
+		v := &vertex{index: i, prev: i - 1, next: i + 1}
+		v.area = triangle(v)
+		vertices[i] = v
+		h = append(h, v)
+		v.pos = len(h) - 1
+	}
+	heap.Init(&h)
+
+	for h.Len() > 0 && h[0].area < area {
+		v := heap.Pop(&h).(*vertex)
+		v.removed = true
+		if p := vertices[v.prev]; p != nil {
+			p.next = v.next
+			p.area = triangle(p)
+			heap.Fix(&h, p.pos)
+		}
+		if n := vertices[v.next]; n != nil {
+			n.prev = v.prev
+			n.area = triangle(n)
+			heap.Fix(&h, n.pos)
+		}
+	}
+
+	simple := []geometry.Point{points[0]}
+	for i := 1; i < len(points)-1; i++ {
+		if !vertices[i].removed {
+			simple = append(simple, points[i])
+		}
+	}
+	return append(simple, points[len(points)-1])
+}
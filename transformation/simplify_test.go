@@ -0,0 +1,161 @@
+package transformation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+func TestSimplifyLine(t *testing.T) {
+	tests := map[string]struct {
+		points      []geometry.Point
+		tolerance   float64
+		highQuality bool
+		want        []geometry.Point
+	}{
+		"zigzag": {
+			points:    []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0.05}, {Lng: 2, Lat: -0.05}, {Lng: 3, Lat: 0}},
+			tolerance: 0.1,
+			want:      []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 3, Lat: 0}},
+		},
+		"corners": {
+			points: []geometry.Point{
+				{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0.01}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: 1}, {Lng: 2.01, Lat: 2}, {Lng: 2, Lat: 3},
+			},
+			tolerance:   0.1,
+			highQuality: true,
+			want:        []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: 3}},
+		},
+		"close points": {
+			points:    []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0.05, Lat: 0.05}, {Lng: 0.1, Lat: 0}, {Lng: 1, Lat: 0}},
+			tolerance: 0.1,
+			want:      []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}},
+		},
+		"two points": {
+			points:    []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0.01, Lat: 0}},
+			tolerance: 1,
+			want:      []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0.01, Lat: 0}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			o, err := Simplify(&geometry.LineString{Coordinates: tt.points}, tt.tolerance, tt.highQuality)
+			if err != nil {
+				t.Fatalf("Simplify error %v", err)
+			}
+			ln, ok := o.(*geometry.LineString)
+			if !ok {
+				t.Fatalf("Simplify() = %v, want a LineString", o)
+			}
+			if !reflect.DeepEqual(ln.Coordinates, tt.want) {
+				t.Errorf("Simplify() = %v, want %v", ln.Coordinates, tt.want)
+			}
+		})
+	}
+
+	_, err := Simplify(&geometry.LineString{}, -1, false)
+	if err == nil {
+		t.Errorf("Simplify expected an error for a negative tolerance")
+	}
+}
+
+func TestSimplifyPolygon(t *testing.T) {
+	ring := []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0.001}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: 2}, {Lng: 0, Lat: 2}, {Lng: 0, Lat: 0}}
+	square := []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: 2}, {Lng: 0, Lat: 2}, {Lng: 0, Lat: 0}}
+	tests := map[string]struct {
+		tolerance float64
+		byValue   bool
+		want      []geometry.Point
+	}{
+		"collinear vertex": {tolerance: 0.01, want: square},
+		// a large tolerance would collapse the ring
+		"kept valid": {tolerance: 10, want: square},
+		"by value":   {tolerance: 0.01, byValue: true, want: square},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			poly := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: ring}}}
+			var in interface{} = &poly
+			if tt.byValue {
+				in = poly
+			}
+			o, err := Simplify(in, tt.tolerance, false)
+			if err != nil {
+				t.Fatalf("Simplify error %v", err)
+			}
+			got, ok := o.(*geometry.Polygon)
+			if !ok {
+				t.Fatalf("Simplify() = %v, want a Polygon", o)
+			}
+			if !reflect.DeepEqual(got.Coordinates[0].Coordinates, tt.want) {
+				t.Errorf("Simplify() = %v, want %v", got.Coordinates[0].Coordinates, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimplifyVisvalingam(t *testing.T) {
+	ln := geometry.LineString{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0.01}, {Lng: 2, Lat: 0}, {Lng: 3, Lat: 2}, {Lng: 4, Lat: 1},
+	}}
+	o, err := SimplifyVisvalingam(&ln, 0.1)
+	if err != nil {
+		t.Fatalf("SimplifyVisvalingam error %v", err)
+	}
+	want := []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 3, Lat: 2}, {Lng: 4, Lat: 1}}
+	if !reflect.DeepEqual(o.(*geometry.LineString).Coordinates, want) {
+		t.Errorf("SimplifyVisvalingam() = %v, want %v", o, want)
+	}
+
+	// the triangles of the neighbours of a removed point are updated
+	o, err = SimplifyVisvalingam(&ln, 1.8)
+	if err != nil {
+		t.Fatalf("SimplifyVisvalingam error %v", err)
+	}
+	want = []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 4, Lat: 1}}
+	if !reflect.DeepEqual(o.(*geometry.LineString).Coordinates, want) {
+		t.Errorf("SimplifyVisvalingam() = %v, want %v", o, want)
+	}
+
+	square := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 1}, {Lng: 0, Lat: 0},
+	}}}}
+	o, err = SimplifyVisvalingam(&square, 100)
+	if err != nil {
+		t.Fatalf("SimplifyVisvalingam error %v", err)
+	}
+	assert.Equal(t, len(o.(*geometry.Polygon).Coordinates[0].Coordinates), 5)
+
+	_, err = SimplifyVisvalingam(&square, -1)
+	if err == nil {
+		t.Errorf("SimplifyVisvalingam expected an error for a negative area")
+	}
+}
+
+func TestSimplifyFeature(t *testing.T) {
+	gjson := `{"type":"Feature","properties":{},"geometry":{"type":"GeometryCollection","geometries":[
+		{"type":"Point","coordinates":[1,2]},
+		{"type":"LineString","coordinates":[[0,0],[1,0.05],[2,-0.05],[3,0]]}
+	]}}`
+	f, err := feature.FromJSON(gjson)
+	if err != nil {
+		t.Fatalf("FromJSON error %v", err)
+	}
+	o, err := Simplify(f, 0.1, true)
+	if err != nil {
+		t.Fatalf("Simplify error %v", err)
+	}
+	c, ok := o.(*geometry.Collection)
+	if !ok {
+		t.Fatalf("Simplify() = %v, want a Collection", o)
+	}
+	objects, err := c.Objects()
+	if err != nil {
+		t.Fatalf("Objects error %v", err)
+	}
+	assert.Equal(t, *objects[0].(*geometry.Point), geometry.Point{Lng: 1, Lat: 2})
+	assert.Equal(t, len(objects[1].(*geometry.LineString).Coordinates), 2)
+}
@@ -0,0 +1,206 @@
+package transformation
+
+// edge types of the sweep events, telling how an edge overlapping an edge of the other polygon contributes to the result
+const (
+	normal = iota
+	nonContributing
+	sameTransition
+	differentTransition
+)
+
+// sweepEvent is an end point of an edge of the polygons met by the sweep line of the clipping algorithm.
+type sweepEvent struct {
+	point [2]float64
+	// left is true if the point is the left end point of the edge.
+	left bool
+	// otherEvent is the event of the other end point of the edge.
+	otherEvent *sweepEvent
+	// isSubject is true if the edge belongs to the subject polygon, false if it belongs to the clipping one.
+	isSubject bool
+	edgeType  int
+	// inOut is true if the edge is an in-out transition of its polygon for a vertical ray from below.
+	inOut bool
+	// otherInOut is true if the closest edge of the other polygon below the edge is an in-out transition.
+	otherInOut bool
+	// resultTransition is 1 if the edge is an out-in transition of the result, -1 if it is an in-out one and 0 if
+	// it isn't in the result.
+	resultTransition int
+	contourID        int
+}
+
+func newSweepEvent(point [2]float64, left bool, otherEvent *sweepEvent, isSubject bool) *sweepEvent {
+	return &sweepEvent{
+		point:      point,
+		left:       left,
+		otherEvent: otherEvent,
+		isSubject:  isSubject,
+		edgeType:   normal,
+	}
+}
+
+// isBelow returns true if the edge of the event is below the point.
+func (e *sweepEvent) isBelow(p [2]float64) bool {
+	p0 := e.point
+	p1 := e.otherEvent.point
+	if e.left {
+		return (p0[0]-p[0])*(p1[1]-p[1])-(p1[0]-p[0])*(p0[1]-p[1]) > 0
+	}
+	return (p1[0]-p[0])*(p0[1]-p[1])-(p0[0]-p[0])*(p1[1]-p[1]) > 0
+}
+
+func (e *sweepEvent) isAbove(p [2]float64) bool {
+	return !e.isBelow(p)
+}
+
+func (e *sweepEvent) isVertical() bool {
+	return e.point[0] == e.otherEvent.point[0]
+}
+
+func (e *sweepEvent) inResult() bool {
+	return e.resultTransition != 0
+}
+
+// signedArea returns twice the signed area of the triangle, positive if its points are counterclockwise.
+func signedArea(p0 [2]float64, p1 [2]float64, p2 [2]float64) float64 {
+	return (p0[0]-p2[0])*(p1[1]-p2[1]) - (p1[0]-p2[0])*(p0[1]-p2[1])
+}
+
+// compareEvents orders the events as they are met by the sweep line, from left to right and from bottom to top.
+func compareEvents(e1 *sweepEvent, e2 *sweepEvent) int {
+	p1 := e1.point
+	p2 := e2.point
+
+	// different x coordinate
+	if p1[0] > p2[0] {
+		return 1
+	}
+	if p1[0] < p2[0] {
+		return -1
+	}
+	// different points with the same x coordinate, the one with the lower y coordinate is processed first
+	if p1[1] != p2[1] {
+		if p1[1] > p2[1] {
+			return 1
+		}
+		return -1
+	}
+
+	// same point, the right end point is processed first
+	if e1.left != e2.left {
+		if e1.left {
+			return 1
+		}
+		return -1
+	}
+	// same point and both left or right end points of edges which aren't collinear,
+	// the event of the bottom edge is processed first
+	if signedArea(p1, e1.otherEvent.point, e2.otherEvent.point) != 0 {
+		if !e1.isBelow(e2.otherEvent.point) {
+			return 1
+		}
+		return -1
+	}
+	// collinear edges, the edge of the clipping polygon is processed first
+	if !e1.isSubject && e2.isSubject {
+		return 1
+	}
+	return -1
+}
+
+// compareSegments orders the edges of the left events in the sweep line from bottom to top.
+func compareSegments(le1 *sweepEvent, le2 *sweepEvent) int {
+	if le1 == le2 {
+		return 0
+	}
+
+	// the edges aren't collinear
+	if signedArea(le1.point, le1.otherEvent.point, le2.point) != 0 ||
+		signedArea(le1.point, le1.otherEvent.point, le2.otherEvent.point) != 0 {
+		// the edges share the left end point, the right end point sorts them
+		if le1.point == le2.point {
+			if le1.isBelow(le2.otherEvent.point) {
+				return -1
+			}
+			return 1
+		}
+		// different left end points with the same x coordinate
+		if le1.point[0] == le2.point[0] {
+			if le1.point[1] < le2.point[1] {
+				return -1
+			}
+			return 1
+		}
+		// the edge of le1 has been inserted in the sweep line after the edge of le2,
+		// a left end point lying on the other edge is sorted by the right end point
+		if compareEvents(le1, le2) == 1 {
+			p := le1.point
+			if onLine(p, le2.point, le2.otherEvent.point) {
+				p = le1.otherEvent.point
+			}
+			if le2.isAbove(p) {
+				return -1
+			}
+			return 1
+		}
+		// the edge of le2 has been inserted in the sweep line after the edge of le1
+		p := le2.point
+		if onLine(p, le1.point, le1.otherEvent.point) {
+			p = le2.otherEvent.point
+		}
+		if le1.isBelow(p) {
+			return -1
+		}
+		return 1
+	}
+
+	if le1.isSubject == le2.isSubject {
+		// collinear edges of the same polygon
+		if le1.point == le2.point {
+			if le1.otherEvent.point == le2.otherEvent.point {
+				return 0
+			}
+			if le1.contourID > le2.contourID {
+				return 1
+			}
+			return -1
+		}
+	} else {
+		// collinear edges of different polygons
+		if le1.isSubject {
+			return -1
+		}
+		return 1
+	}
+
+	if compareEvents(le1, le2) == 1 {
+		return 1
+	}
+	return -1
+}
+
+// eventQueue is a priority queue of the events ordered by compareEvents, implementing heap.Interface.
+type eventQueue []*sweepEvent
+
+func (q eventQueue) Len() int {
+	return len(q)
+}
+
+func (q eventQueue) Less(i int, j int) bool {
+	return compareEvents(q[i], q[j]) < 0
+}
+
+func (q eventQueue) Swap(i int, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *eventQueue) Push(x interface{}) {
+	*q = append(*q, x.(*sweepEvent))
+}
+
+func (q *eventQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	e := old[n-1]
+	*q = old[:n-1]
+	return e
+}
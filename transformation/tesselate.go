@@ -0,0 +1,72 @@
+package transformation
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// Tesselate triangulates a Polygon or a MultiPolygon with the earcut ear clipping algorithm and returns the triangles
+// as Polygon features with counterclockwise rings. The holes of the polygons aren't covered by the triangles.
+// t can be a *geometry.Polygon, a *geometry.MultiPolygon, a *geometry.Geometry or a *feature.Feature of them.
+func Tesselate(t interface{}) (*feature.Collection, error) {
+	var polygons []geometry.Polygon
+	switch gtp := geometry.Pointer(t).(type) {
+	case *feature.Feature:
+		return Tesselate(&gtp.Geometry)
+	case *geometry.Geometry:
+		o, err := gtp.ToObject()
+		if err != nil {
+			return nil, err
+		}
+		return Tesselate(o)
+	case *geometry.Polygon:
+		polygons = []geometry.Polygon{*gtp}
+	case *geometry.MultiPolygon:
+		polygons = gtp.Coordinates
+	default:
+		return nil, errors.New("the geometry must be a Polygon or a MultiPolygon")
+	}
+
+	features := []feature.Feature{}
+	for _, poly := range polygons {
+		triangles, points := tesselatePolygon(poly)
+		for i := 0; i+2 < len(triangles); i += 3 {
+			a, b, c := points[triangles[i]], points[triangles[i+1]], points[triangles[i+2]]
+			ring := []geometry.Point{a, b, c, a}
+			g, err := geometry.NewGeometry(&geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: ring}}})
+			if err != nil {
+				return nil, err
+			}
+			f, err := feature.New(*g, nil, nil, feature.ID{})
+			if err != nil {
+				return nil, err
+			}
+			features = append(features, *f)
+		}
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// tesselatePolygon returns the triangles of the polygon as indexes in its points, its rings without their closing
+// point.
+func tesselatePolygon(poly geometry.Polygon) ([]int, []geometry.Point) {
+	var points []geometry.Point
+	var data []float64
+	var holeIndices []int
+	for i, r := range poly.Coordinates {
+		coords := r.Coordinates
+		if len(coords) > 1 && coords[0].Lng == coords[len(coords)-1].Lng && coords[0].Lat == coords[len(coords)-1].Lat {
+			coords = coords[:len(coords)-1]
+		}
+		if i > 0 {
+			holeIndices = append(holeIndices, len(points))
+		}
+		for _, p := range coords {
+			points = append(points, p)
+			data = append(data, p.Lng, p.Lat)
+		}
+	}
+	return earcut(data, holeIndices), points
+}
@@ -0,0 +1,126 @@
+package transformation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson/feature"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+	"github.com/tomchavakis/turf-go/utils"
+)
+
+// polygonArea returns the planar area of the polygon, its outer ring less its holes.
+func polygonArea(poly geometry.Polygon) float64 {
+	area := 0.0
+	for i, r := range poly.Coordinates {
+		a := math.Abs(ringArea(r.Coordinates)) / 2
+		if i > 0 {
+			a = -a
+		}
+		area += a
+	}
+	return area
+}
+
+func TestTesselate(t *testing.T) {
+	square := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 4, Lat: 0}, {Lng: 4, Lat: 4}, {Lng: 0, Lat: 4}, {Lng: 0, Lat: 0}}},
+	}}
+	// a clockwise outer ring and a hole
+	withHole := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0, Lat: 4}, {Lng: 4, Lat: 4}, {Lng: 4, Lat: 0}, {Lng: 0, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 1, Lat: 1}, {Lng: 3, Lat: 1}, {Lng: 3, Lat: 3}, {Lng: 1, Lat: 3}, {Lng: 1, Lat: 1}}},
+	}}
+	// a comb with reflex vertices
+	comb := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{
+			{Lng: 0, Lat: 0}, {Lng: 5, Lat: 0}, {Lng: 5, Lat: 3}, {Lng: 4, Lat: 3}, {Lng: 4, Lat: 1},
+			{Lng: 3, Lat: 1}, {Lng: 3, Lat: 3}, {Lng: 2, Lat: 3}, {Lng: 2, Lat: 1}, {Lng: 1, Lat: 1},
+			{Lng: 1, Lat: 3}, {Lng: 0, Lat: 3}, {Lng: 0, Lat: 0},
+		}},
+	}}
+
+	tests := map[string]struct {
+		geometry  interface{}
+		triangles int
+		area      float64
+	}{
+		"square":        {geometry: &square, triangles: 2, area: 16},
+		"hole":          {geometry: &withHole, triangles: 8, area: 12},
+		"comb":          {geometry: &comb, triangles: 10, area: 11},
+		"multi polygon": {geometry: &geometry.MultiPolygon{Coordinates: []geometry.Polygon{square, withHole}}, triangles: 10, area: 28},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fc, err := Tesselate(tt.geometry)
+			if err != nil {
+				t.Fatalf("Tesselate error %v", err)
+			}
+			assert.Equal(t, len(fc.Features), tt.triangles)
+			area := 0.0
+			for _, f := range fc.Features {
+				poly, err := f.ToPolygon()
+				if err != nil {
+					t.Fatalf("ToPolygon error %v", err)
+				}
+				a := ringArea(poly.Coordinates[0].Coordinates) / 2
+				if a <= 0 {
+					t.Errorf("triangle %v isn't counterclockwise", poly.Coordinates[0].Coordinates)
+				}
+				area += a
+			}
+			if math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+
+	_, err := Tesselate(&geometry.LineString{})
+	if err == nil {
+		t.Errorf("Tesselate expected an error for a line")
+	}
+}
+
+func TestTesselateFixture(t *testing.T) {
+	gjson, err := utils.LoadJSONFixture("../test-data/multipoly-with-hole.json")
+	if err != nil {
+		t.Fatalf("cannot load fixture: %v", err)
+	}
+	f, err := feature.FromJSON(gjson)
+	if err != nil {
+		t.Fatalf("FromJSON error %v", err)
+	}
+	mp, err := f.ToMultiPolygon()
+	if err != nil {
+		t.Fatalf("ToMultiPolygon error %v", err)
+	}
+
+	fc, err := Tesselate(f)
+	if err != nil {
+		t.Fatalf("Tesselate error %v", err)
+	}
+	// n + 2h - 2 triangles for a polygon of n vertices and h holes
+	triangles := 0
+	want := 0.0
+	for _, poly := range mp.Coordinates {
+		for _, r := range poly.Coordinates {
+			triangles += len(r.Coordinates) - 1 + 2
+		}
+		triangles -= 4
+		want += polygonArea(poly)
+	}
+	assert.Equal(t, len(fc.Features), triangles)
+
+	area := 0.0
+	for _, f := range fc.Features {
+		poly, err := f.ToPolygon()
+		if err != nil {
+			t.Fatalf("ToPolygon error %v", err)
+		}
+		area += polygonArea(*poly)
+	}
+	if math.Abs(area-want) > want*1e-9 {
+		t.Errorf("area = %v, want %v", area, want)
+	}
+}
@@ -0,0 +1,344 @@
+package wkb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// WKB geometry type codes.
+// https://www.ogc.org/standards/sfa
+const (
+	wkbPoint              = 1
+	wkbLineString         = 2
+	wkbPolygon            = 3
+	wkbMultiPoint         = 4
+	wkbMultiLineString    = 5
+	wkbMultiPolygon       = 6
+	wkbGeometryCollection = 7
+)
+
+// EWKB flags which are set in the high bits of the geometry type.
+const (
+	ewkbZ    = 0x80000000
+	ewkbM    = 0x40000000
+	ewkbSRID = 0x20000000
+)
+
+// dims describes the dimensions of the coordinates of a geometry.
+type dims struct {
+	z bool
+	m bool
+}
+
+type decoder struct {
+	b     []byte
+	pos   int
+	order binary.ByteOrder
+}
+
+// Unmarshal decodes a WKB or EWKB geometry into the corresponding geometry type.
+// The SRID of an EWKB geometry is ignored, use UnmarshalEWKB to get its value.
+func Unmarshal(b []byte) (geometry.Object, error) {
+	o, _, err := UnmarshalEWKB(b)
+	return o, err
+}
+
+// UnmarshalEWKB decodes a WKB or EWKB geometry and returns the geometry and its SRID.
+// Both the ISO type codes for Z and M geometries and the EWKB flags are accepted. The SRID is 0 when the input doesn't have one.
+func UnmarshalEWKB(b []byte) (geometry.Object, int, error) {
+	if len(b) == 0 {
+		return nil, 0, errors.New("input cannot be empty")
+	}
+	d := &decoder{b: b}
+	o, srid, err := d.geometry()
+	if err != nil {
+		return nil, 0, err
+	}
+	if d.pos != len(d.b) {
+		return nil, 0, d.errorf("unexpected trailing bytes")
+	}
+	return o, srid, nil
+}
+
+func (d *decoder) geometry() (geometry.Object, int, error) {
+	order, err := d.byte()
+	if err != nil {
+		return nil, 0, err
+	}
+	switch order {
+	case 0:
+		d.order = binary.BigEndian
+	case 1:
+		d.order = binary.LittleEndian
+	default:
+		return nil, 0, d.errorf("invalid byte order %d", order)
+	}
+
+	tp, err := d.uint32()
+	if err != nil {
+		return nil, 0, err
+	}
+	dm := dims{z: tp&ewkbZ != 0, m: tp&ewkbM != 0}
+	srid := 0
+	if tp&ewkbSRID != 0 {
+		v, err := d.uint32()
+		if err != nil {
+			return nil, 0, err
+		}
+		srid = int(int32(v))
+	}
+	tp &^= ewkbZ | ewkbM | ewkbSRID
+	switch tp / 1000 {
+	case 0:
+	case 1:
+		dm.z = true
+	case 2:
+		dm.m = true
+	case 3:
+		dm.z, dm.m = true, true
+	default:
+		return nil, 0, d.errorf("invalid geometry type %d", tp)
+	}
+
+	var o geometry.Object
+	switch tp % 1000 {
+	case wkbPoint:
+		o, err = d.point(dm)
+	case wkbLineString:
+		o, err = d.lineString(dm)
+	case wkbPolygon:
+		o, err = d.polygon(dm)
+	case wkbMultiPoint:
+		o, err = d.multiPoint()
+	case wkbMultiLineString:
+		o, err = d.multiLineString()
+	case wkbMultiPolygon:
+		o, err = d.multiPolygon()
+	case wkbGeometryCollection:
+		o, err = d.collection()
+	default:
+		return nil, 0, d.errorf("invalid geometry type %d", tp)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	return o, srid, nil
+}
+
+func (d *decoder) point(dm dims) (*geometry.Point, error) {
+	pt, err := d.coordinate(dm)
+	if err != nil {
+		return nil, err
+	}
+	if math.IsNaN(pt.Lat) && math.IsNaN(pt.Lng) {
+		return nil, errors.New("empty points are not supported")
+	}
+	return &pt, nil
+}
+
+func (d *decoder) lineString(dm dims) (*geometry.LineString, error) {
+	points, err := d.points(dm)
+	if err != nil {
+		return nil, err
+	}
+	if len(points) == 0 {
+		return &geometry.LineString{}, nil
+	}
+	return geometry.NewLineString(points)
+}
+
+func (d *decoder) polygon(dm dims) (*geometry.Polygon, error) {
+	n, err := d.count(4)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return &geometry.Polygon{}, nil
+	}
+	rings := make([]geometry.LineString, 0, n)
+	for i := 0; i < n; i++ {
+		points, err := d.points(dm)
+		if err != nil {
+			return nil, err
+		}
+		rings = append(rings, geometry.LineString{Coordinates: points})
+	}
+	return geometry.NewPolygon(rings)
+}
+
+func (d *decoder) multiPoint() (*geometry.MultiPoint, error) {
+	objects, err := d.members(wkbPoint)
+	if err != nil {
+		return nil, err
+	}
+	mp := &geometry.MultiPoint{}
+	for _, o := range objects {
+		mp.Coordinates = append(mp.Coordinates, *o.(*geometry.Point))
+	}
+	return mp, nil
+}
+
+func (d *decoder) multiLineString() (*geometry.MultiLineString, error) {
+	objects, err := d.members(wkbLineString)
+	if err != nil {
+		return nil, err
+	}
+	ml := &geometry.MultiLineString{}
+	for _, o := range objects {
+		ml.Coordinates = append(ml.Coordinates, *o.(*geometry.LineString))
+	}
+	return ml, nil
+}
+
+func (d *decoder) multiPolygon() (*geometry.MultiPolygon, error) {
+	objects, err := d.members(wkbPolygon)
+	if err != nil {
+		return nil, err
+	}
+	mp := &geometry.MultiPolygon{}
+	for _, o := range objects {
+		mp.Coordinates = append(mp.Coordinates, *o.(*geometry.Polygon))
+	}
+	return mp, nil
+}
+
+func (d *decoder) collection() (*geometry.Collection, error) {
+	objects, err := d.members(0)
+	if err != nil {
+		return nil, err
+	}
+	gc := &geometry.Collection{}
+	for _, o := range objects {
+		g, err := geometry.NewGeometry(o)
+		if err != nil {
+			return nil, err
+		}
+		gc.Geometries = append(gc.Geometries, *g)
+	}
+	return gc, nil
+}
+
+// members decodes the member geometries of a multi geometry or collection.
+// Every member must be of the given type unless it is 0.
+func (d *decoder) members(tp int) ([]geometry.Object, error) {
+	// the smallest member is an empty geometry which takes 9 bytes
+	n, err := d.count(9)
+	if err != nil {
+		return nil, err
+	}
+	order := d.order
+	objects := make([]geometry.Object, 0, n)
+	for i := 0; i < n; i++ {
+		o, _, err := d.geometry()
+		if err != nil {
+			return nil, err
+		}
+		if tp != 0 && !isType(o, tp) {
+			return nil, d.errorf("invalid member geometry type %v", o.Type())
+		}
+		objects = append(objects, o)
+	}
+	d.order = order
+	return objects, nil
+}
+
+func (d *decoder) points(dm dims) ([]geometry.Point, error) {
+	n, err := d.count(8 * ordinates(dm))
+	if err != nil {
+		return nil, err
+	}
+	points := make([]geometry.Point, 0, n)
+	for i := 0; i < n; i++ {
+		pt, err := d.coordinate(dm)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, pt)
+	}
+	return points, nil
+}
+
+func (d *decoder) coordinate(dm dims) (geometry.Point, error) {
+	if d.pos+8*ordinates(dm) > len(d.b) {
+		return geometry.Point{}, d.errorf("unexpected end of input")
+	}
+	pt := geometry.Point{Lng: d.float64(), Lat: d.float64()}
+	if dm.z {
+		alt := d.float64()
+		pt.Alt = &alt
+	}
+	if dm.m {
+		m := d.float64()
+		pt.M = &m
+	}
+	return pt, nil
+}
+
+// count reads the number of elements which follow, each taking at least size bytes.
+func (d *decoder) count(size int) (int, error) {
+	v, err := d.uint32()
+	if err != nil {
+		return 0, err
+	}
+	n := int(v)
+	if n < 0 || n > (len(d.b)-d.pos)/size {
+		return 0, d.errorf("invalid number of elements %d", v)
+	}
+	return n, nil
+}
+
+func (d *decoder) byte() (byte, error) {
+	if d.pos >= len(d.b) {
+		return 0, d.errorf("unexpected end of input")
+	}
+	b := d.b[d.pos]
+	d.pos++
+	return b, nil
+}
+
+func (d *decoder) uint32() (uint32, error) {
+	if d.pos+4 > len(d.b) {
+		return 0, d.errorf("unexpected end of input")
+	}
+	v := d.order.Uint32(d.b[d.pos:])
+	d.pos += 4
+	return v, nil
+}
+
+// float64 reads the next float, the caller must have checked the length of the input.
+func (d *decoder) float64() float64 {
+	v := math.Float64frombits(d.order.Uint64(d.b[d.pos:]))
+	d.pos += 8
+	return v
+}
+
+func (d *decoder) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("wkb: invalid input at offset %d: %s", d.pos, fmt.Sprintf(format, args...))
+}
+
+func ordinates(dm dims) int {
+	n := 2
+	if dm.z {
+		n++
+	}
+	if dm.m {
+		n++
+	}
+	return n
+}
+
+func isType(o geometry.Object, tp int) bool {
+	switch o.(type) {
+	case *geometry.Point:
+		return tp == wkbPoint
+	case *geometry.LineString:
+		return tp == wkbLineString
+	case *geometry.Polygon:
+		return tp == wkbPolygon
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package wkb
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+func TestUnmarshal(t *testing.T) {
+	alt := 3.0
+	m := 4.0
+	tests := map[string]struct {
+		hex     string
+		want    geometry.Object
+		wantErr bool
+	}{
+		"point little endian": {
+			hex:  "0101000000000000000000F03F0000000000000040",
+			want: &geometry.Point{Lng: 1, Lat: 2},
+		},
+		"point big endian": {
+			hex:  "00000000013FF00000000000004000000000000000",
+			want: &geometry.Point{Lng: 1, Lat: 2},
+		},
+		"point z iso": {
+			hex:  "01E9030000000000000000F03F00000000000000400000000000000840",
+			want: &geometry.Point{Lng: 1, Lat: 2, Alt: &alt},
+		},
+		"point zm ewkb": {
+			hex:  "01010000C0000000000000F03F000000000000004000000000000008400000000000001040",
+			want: &geometry.Point{Lng: 1, Lat: 2, Alt: &alt, M: &m},
+		},
+		"lineString": {
+			hex:  "010200000002000000000000000000F03F000000000000004000000000000008400000000000001040",
+			want: &geometry.LineString{Coordinates: []geometry.Point{{Lng: 1, Lat: 2}, {Lng: 3, Lat: 4}}},
+		},
+		"polygon": {
+			hex: "0103000000010000000400000000000000000000000000000000000000000000000000F03F0000000000000000000000000000F03F000000000000F03F00000000000000000000000000000000",
+			want: &geometry.Polygon{Coordinates: []geometry.LineString{
+				{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}}},
+			}},
+		},
+		"multiPoint": {
+			hex:  "0104000000020000000101000000000000000000F03F0000000000000040010100000000000000000008400000000000001040",
+			want: &geometry.MultiPoint{Coordinates: []geometry.Point{{Lng: 1, Lat: 2}, {Lng: 3, Lat: 4}}},
+		},
+		"geometryCollection": {
+			hex: "0107000000010000000101000000000000000000F03F0000000000000040",
+			want: &geometry.Collection{Geometries: []geometry.Geometry{
+				{GeoJSONType: geojson.Point, Coordinates: []float64{1, 2}},
+			}},
+		},
+		"empty point": {
+			hex:     "0101000000000000000000F87F000000000000F87F",
+			wantErr: true,
+		},
+		"invalid member": {
+			hex:     "010400000001000000010200000000000000",
+			wantErr: true,
+		},
+		"truncated": {
+			hex:     "0101000000000000000000F03F",
+			wantErr: true,
+		},
+		"invalid byte order": {
+			hex:     "0201000000000000000000F03F0000000000000040",
+			wantErr: true,
+		},
+		"invalid count": {
+			hex:     "0102000000FFFFFFFF",
+			wantErr: true,
+		},
+		"empty input": {
+			hex:     "",
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			b, err := hex.DecodeString(tt.hex)
+			if err != nil {
+				t.Fatalf("invalid test input %v", err)
+			}
+			got, err := Unmarshal(b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unmarshal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalEWKB(t *testing.T) {
+	b, _ := hex.DecodeString("0101000020E6100000000000000000F03F0000000000000040")
+	o, srid, err := UnmarshalEWKB(b)
+	if err != nil {
+		t.Errorf("UnmarshalEWKB error %v", err)
+	}
+	assert.Equal(t, srid, 4326)
+	assert.Equal(t, reflect.DeepEqual(o, &geometry.Point{Lng: 1, Lat: 2}), true)
+}
@@ -0,0 +1,206 @@
+package wkb
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+type encoder struct {
+	b     []byte
+	order binary.ByteOrder
+	// ewkb is true when the dimensions are written with the EWKB flags instead of the ISO type codes.
+	ewkb bool
+}
+
+// Marshal returns the WKB representation of the geometry in the given byte order.
+// Geometries with altitude or measure values are written with the ISO type codes.
+func Marshal(o geometry.Object, order binary.ByteOrder) ([]byte, error) {
+	e := &encoder{order: order}
+	err := e.write(o, nil)
+	if err != nil {
+		return nil, err
+	}
+	return e.b, nil
+}
+
+// MarshalEWKB returns the EWKB representation of the geometry in the given byte order including its SRID.
+func MarshalEWKB(o geometry.Object, srid int, order binary.ByteOrder) ([]byte, error) {
+	e := &encoder{order: order, ewkb: true}
+	err := e.write(o, &srid)
+	if err != nil {
+		return nil, err
+	}
+	return e.b, nil
+}
+
+func (e *encoder) write(o geometry.Object, srid *int) error {
+	switch gtp := o.(type) {
+	case *geometry.Point:
+		return e.write(*gtp, srid)
+	case *geometry.MultiPoint:
+		return e.write(*gtp, srid)
+	case *geometry.LineString:
+		return e.write(*gtp, srid)
+	case *geometry.MultiLineString:
+		return e.write(*gtp, srid)
+	case *geometry.Polygon:
+		return e.write(*gtp, srid)
+	case *geometry.MultiPolygon:
+		return e.write(*gtp, srid)
+	case *geometry.Collection:
+		return e.write(*gtp, srid)
+	case geometry.Point:
+		d := dimsOf([]geometry.Point{gtp})
+		e.header(wkbPoint, d, srid)
+		e.coordinate(gtp, d)
+	case geometry.MultiPoint:
+		d := dimsOf(gtp.Coordinates)
+		e.header(wkbMultiPoint, d, srid)
+		e.uint32(uint32(len(gtp.Coordinates)))
+		for _, p := range gtp.Coordinates {
+			e.header(wkbPoint, d, nil)
+			e.coordinate(p, d)
+		}
+	case geometry.LineString:
+		d := dimsOf(gtp.Coordinates)
+		e.header(wkbLineString, d, srid)
+		e.points(gtp.Coordinates, d)
+	case geometry.MultiLineString:
+		d := dimsOf(linePoints(gtp.Coordinates))
+		e.header(wkbMultiLineString, d, srid)
+		e.uint32(uint32(len(gtp.Coordinates)))
+		for _, l := range gtp.Coordinates {
+			e.header(wkbLineString, d, nil)
+			e.points(l.Coordinates, d)
+		}
+	case geometry.Polygon:
+		d := dimsOf(linePoints(gtp.Coordinates))
+		e.header(wkbPolygon, d, srid)
+		e.rings(gtp.Coordinates, d)
+	case geometry.MultiPolygon:
+		var points []geometry.Point
+		for _, p := range gtp.Coordinates {
+			points = append(points, linePoints(p.Coordinates)...)
+		}
+		d := dimsOf(points)
+		e.header(wkbMultiPolygon, d, srid)
+		e.uint32(uint32(len(gtp.Coordinates)))
+		for _, p := range gtp.Coordinates {
+			e.header(wkbPolygon, d, nil)
+			e.rings(p.Coordinates, d)
+		}
+	case geometry.Collection:
+		objects, err := gtp.Objects()
+		if err != nil {
+			return err
+		}
+		e.header(wkbGeometryCollection, dims{}, srid)
+		e.uint32(uint32(len(objects)))
+		for _, o := range objects {
+			err = e.write(o, nil)
+			if err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.New("unsupported geometry type")
+	}
+	return nil
+}
+
+// header writes the byte order, the geometry type and the SRID if it isn't nil.
+func (e *encoder) header(tp uint32, d dims, srid *int) {
+	if e.order == binary.BigEndian {
+		e.b = append(e.b, 0)
+	} else {
+		e.b = append(e.b, 1)
+	}
+	if e.ewkb {
+		if d.z {
+			tp |= ewkbZ
+		}
+		if d.m {
+			tp |= ewkbM
+		}
+		if srid != nil {
+			tp |= ewkbSRID
+		}
+	} else {
+		if d.z {
+			tp += 1000
+		}
+		if d.m {
+			tp += 2000
+		}
+	}
+	e.uint32(tp)
+	if e.ewkb && srid != nil {
+		e.uint32(uint32(int32(*srid)))
+	}
+}
+
+func (e *encoder) rings(rings []geometry.LineString, d dims) {
+	e.uint32(uint32(len(rings)))
+	for _, r := range rings {
+		e.points(r.Coordinates, d)
+	}
+}
+
+func (e *encoder) points(points []geometry.Point, d dims) {
+	e.uint32(uint32(len(points)))
+	for _, p := range points {
+		e.coordinate(p, d)
+	}
+}
+
+// coordinate writes the ordinates of the point, a missing altitude or measure is written as 0.
+func (e *encoder) coordinate(p geometry.Point, d dims) {
+	e.float64(p.Lng)
+	e.float64(p.Lat)
+	if d.z {
+		e.float64(valueOrZero(p.Alt))
+	}
+	if d.m {
+		e.float64(valueOrZero(p.M))
+	}
+}
+
+func (e *encoder) uint32(v uint32) {
+	var b [4]byte
+	e.order.PutUint32(b[:], v)
+	e.b = append(e.b, b[:]...)
+}
+
+func (e *encoder) float64(v float64) {
+	var b [8]byte
+	e.order.PutUint64(b[:], math.Float64bits(v))
+	e.b = append(e.b, b[:]...)
+}
+
+// dimsOf returns the dimensions of a list of points, a dimension is present if any of the points has it.
+func dimsOf(points []geometry.Point) dims {
+	d := dims{}
+	for _, p := range points {
+		d.z = d.z || p.Alt != nil
+		d.m = d.m || p.M != nil
+	}
+	return d
+}
+
+func linePoints(lines []geometry.LineString) []geometry.Point {
+	var points []geometry.Point
+	for _, l := range lines {
+		points = append(points, l.Coordinates...)
+	}
+	return points
+}
+
+func valueOrZero(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,100 @@
+package wkb
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+func TestMarshal(t *testing.T) {
+	alt := 3.0
+	tests := map[string]struct {
+		object geometry.Object
+		order  binary.ByteOrder
+		want   string
+	}{
+		"point little endian": {
+			object: geometry.Point{Lng: 1, Lat: 2},
+			order:  binary.LittleEndian,
+			want:   "0101000000000000000000F03F0000000000000040",
+		},
+		"point big endian": {
+			object: &geometry.Point{Lng: 1, Lat: 2},
+			order:  binary.BigEndian,
+			want:   "00000000013FF00000000000004000000000000000",
+		},
+		"point z": {
+			object: geometry.Point{Lng: 1, Lat: 2, Alt: &alt},
+			order:  binary.LittleEndian,
+			want:   "01E9030000000000000000F03F00000000000000400000000000000840",
+		},
+		"lineString": {
+			object: geometry.LineString{Coordinates: []geometry.Point{{Lng: 1, Lat: 2}, {Lng: 3, Lat: 4}}},
+			order:  binary.LittleEndian,
+			want:   "010200000002000000000000000000F03F000000000000004000000000000008400000000000001040",
+		},
+		"multiPoint": {
+			object: geometry.MultiPoint{Coordinates: []geometry.Point{{Lng: 1, Lat: 2}, {Lng: 3, Lat: 4}}},
+			order:  binary.LittleEndian,
+			want:   "0104000000020000000101000000000000000000F03F0000000000000040010100000000000000000008400000000000001040",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Marshal(tt.object, tt.order)
+			if err != nil {
+				t.Errorf("Marshal error %v", err)
+			}
+			assert.Equal(t, strings.ToUpper(hex.EncodeToString(got)), tt.want)
+		})
+	}
+}
+
+func TestMarshalEWKB(t *testing.T) {
+	got, err := MarshalEWKB(geometry.Point{Lng: 1, Lat: 2}, 4326, binary.LittleEndian)
+	if err != nil {
+		t.Errorf("MarshalEWKB error %v", err)
+	}
+	assert.Equal(t, strings.ToUpper(hex.EncodeToString(got)), "0101000020E6100000000000000000F03F0000000000000040")
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	alt := 10.0
+	m := 20.0
+	objects := []geometry.Object{
+		&geometry.Polygon{Coordinates: []geometry.LineString{
+			{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}}},
+		}},
+		&geometry.MultiLineString{Coordinates: []geometry.LineString{
+			{Coordinates: []geometry.Point{{Lng: 1, Lat: 2, Alt: &alt, M: &m}, {Lng: 3, Lat: 4, Alt: &alt, M: &m}}},
+		}},
+		&geometry.MultiPolygon{Coordinates: []geometry.Polygon{
+			{Coordinates: []geometry.LineString{
+				{Coordinates: []geometry.Point{{Lng: 0, Lat: 0, Alt: &alt}, {Lng: 1, Lat: 0, Alt: &alt}, {Lng: 1, Lat: 1, Alt: &alt}, {Lng: 0, Lat: 0, Alt: &alt}}},
+			}},
+		}},
+	}
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		for _, o := range objects {
+			b, err := MarshalEWKB(o, 3857, order)
+			if err != nil {
+				t.Errorf("MarshalEWKB error %v", err)
+				continue
+			}
+			got, srid, err := UnmarshalEWKB(b)
+			if err != nil {
+				t.Errorf("UnmarshalEWKB error %v", err)
+				continue
+			}
+			assert.Equal(t, srid, 3857)
+			if !reflect.DeepEqual(got, o) {
+				t.Errorf("round trip = %v, want %v", got, o)
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package wkb
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// GeometryScanner implements sql.Scanner and decodes a WKB or EWKB column into a geometry.
+type GeometryScanner struct {
+	g interface{}
+	// SRID is the SRID of the scanned EWKB geometry, it is 0 when the column doesn't have one.
+	SRID int
+	// Valid is false when the scanned column was NULL, in which case the geometry is left unchanged.
+	Valid bool
+}
+
+// Scanner returns a sql.Scanner which decodes a WKB or EWKB column into g.
+// g must be a pointer to a geometry type such as *geometry.Polygon, a *geometry.Geometry or a *geometry.Object.
+//
+//	var poly geometry.Polygon
+//	err := db.QueryRow("SELECT geom FROM areas WHERE id = $1", id).Scan(wkb.Scanner(&poly))
+func Scanner(g interface{}) *GeometryScanner {
+	return &GeometryScanner{g: g}
+}
+
+// Scan decodes the column value which may be raw or hex encoded WKB.
+func (s *GeometryScanner) Scan(src interface{}) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+		s.Valid = false
+		return nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into a geometry", src)
+	}
+
+	// raw WKB starts with the byte order which is 0 or 1, anything else is hex encoded
+	if len(b) > 0 && b[0] != 0 && b[0] != 1 {
+		decoded := make([]byte, hex.DecodedLen(len(b)))
+		_, err := hex.Decode(decoded, b)
+		if err != nil {
+			return err
+		}
+		b = decoded
+	}
+
+	o, srid, err := UnmarshalEWKB(b)
+	if err != nil {
+		return err
+	}
+	err = s.assign(o)
+	if err != nil {
+		return err
+	}
+	s.SRID = srid
+	s.Valid = true
+	return nil
+}
+
+func (s *GeometryScanner) assign(o geometry.Object) error {
+	mismatch := fmt.Errorf("cannot scan a %v into %T", o.Type(), s.g)
+	switch t := s.g.(type) {
+	case *geometry.Object:
+		*t = o
+	case *geometry.Geometry:
+		g, err := geometry.NewGeometry(o)
+		if err != nil {
+			return err
+		}
+		*t = *g
+	case *geometry.Point:
+		v, ok := o.(*geometry.Point)
+		if !ok {
+			return mismatch
+		}
+		*t = *v
+	case *geometry.MultiPoint:
+		v, ok := o.(*geometry.MultiPoint)
+		if !ok {
+			return mismatch
+		}
+		*t = *v
+	case *geometry.LineString:
+		v, ok := o.(*geometry.LineString)
+		if !ok {
+			return mismatch
+		}
+		*t = *v
+	case *geometry.MultiLineString:
+		v, ok := o.(*geometry.MultiLineString)
+		if !ok {
+			return mismatch
+		}
+		*t = *v
+	case *geometry.Polygon:
+		v, ok := o.(*geometry.Polygon)
+		if !ok {
+			return mismatch
+		}
+		*t = *v
+	case *geometry.MultiPolygon:
+		v, ok := o.(*geometry.MultiPolygon)
+		if !ok {
+			return mismatch
+		}
+		*t = *v
+	case *geometry.Collection:
+		v, ok := o.(*geometry.Collection)
+		if !ok {
+			return mismatch
+		}
+		*t = *v
+	default:
+		return errors.New("unsupported scan destination")
+	}
+	return nil
+}
+
+// GeometryValuer implements driver.Valuer and encodes a geometry as little endian WKB,
+// or EWKB when the SRID isn't 0.
+type GeometryValuer struct {
+	Object geometry.Object
+	SRID   int
+}
+
+// Value returns a driver.Valuer which encodes the geometry as WKB.
+//
+//	_, err := db.Exec("INSERT INTO areas(geom) VALUES (ST_GeomFromWKB($1))", wkb.Value(poly))
+func Value(o geometry.Object) GeometryValuer {
+	return GeometryValuer{Object: o}
+}
+
+// Value encodes the geometry, a nil geometry is encoded as NULL.
+func (v GeometryValuer) Value() (driver.Value, error) {
+	if v.Object == nil {
+		return nil, nil
+	}
+	if v.SRID != 0 {
+		return MarshalEWKB(v.Object, v.SRID, binary.LittleEndian)
+	}
+	return Marshal(v.Object, binary.LittleEndian)
+}
@@ -0,0 +1,78 @@
+package wkb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+const polygonHex = "0103000020E6100000010000000400000000000000000000000000000000000000000000000000F03F0000000000000000000000000000F03F000000000000F03F00000000000000000000000000000000"
+
+func TestScanner(t *testing.T) {
+	want := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}}},
+	}}
+
+	var poly geometry.Polygon
+	s := Scanner(&poly)
+	err := s.Scan([]byte(polygonHex))
+	if err != nil {
+		t.Errorf("Scan error %v", err)
+	}
+	assert.Equal(t, s.Valid, true)
+	assert.Equal(t, s.SRID, 4326)
+	assert.Equal(t, reflect.DeepEqual(poly, want), true)
+
+	b, err := Value(poly).Value()
+	if err != nil {
+		t.Errorf("Value error %v", err)
+	}
+	var o geometry.Object
+	err = Scanner(&o).Scan(b)
+	if err != nil {
+		t.Errorf("Scan error %v", err)
+	}
+	assert.Equal(t, reflect.DeepEqual(o, &want), true)
+
+	var g geometry.Geometry
+	err = Scanner(&g).Scan(polygonHex)
+	if err != nil {
+		t.Errorf("Scan error %v", err)
+	}
+	assert.Equal(t, g.GeoJSONType, geojson.Polygon)
+
+	s = Scanner(&poly)
+	err = s.Scan(nil)
+	if err != nil {
+		t.Errorf("Scan error %v", err)
+	}
+	assert.Equal(t, s.Valid, false)
+
+	var p geometry.Point
+	err = Scanner(&p).Scan(polygonHex)
+	if err == nil {
+		t.Errorf("Scan expected an error for a mismatched geometry type")
+	}
+}
+
+func TestValue(t *testing.T) {
+	v, err := GeometryValuer{Object: geometry.Point{Lng: 1, Lat: 2}, SRID: 4326}.Value()
+	if err != nil {
+		t.Errorf("Value error %v", err)
+	}
+	o, srid, err := UnmarshalEWKB(v.([]byte))
+	if err != nil {
+		t.Errorf("UnmarshalEWKB error %v", err)
+	}
+	assert.Equal(t, srid, 4326)
+	assert.Equal(t, reflect.DeepEqual(o, &geometry.Point{Lng: 1, Lat: 2}), true)
+
+	v, err = Value(nil).Value()
+	if err != nil {
+		t.Errorf("Value error %v", err)
+	}
+	assert.Equal(t, v == nil, true)
+}
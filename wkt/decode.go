@@ -0,0 +1,407 @@
+package wkt
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+// dims describes the dimensions of the coordinates of a geometry.
+type dims struct {
+	z bool
+	m bool
+	// explicit is true when the dimensions were given with a Z, M or ZM tag.
+	// Otherwise they are inferred from the number of ordinates of the first coordinate.
+	explicit bool
+}
+
+type parser struct {
+	s   string
+	pos int
+}
+
+// Unmarshal parses a WKT geometry into the corresponding geometry type.
+// An EWKT SRID prefix is accepted and ignored, use UnmarshalEWKT to get its value.
+func Unmarshal(s string) (geometry.Object, error) {
+	o, _, err := UnmarshalEWKT(s)
+	return o, err
+}
+
+// UnmarshalEWKT parses an EWKT geometry such as `SRID=4326;POINT(1 2)` and returns the geometry and its SRID.
+// The SRID is 0 when the input doesn't have one.
+func UnmarshalEWKT(s string) (geometry.Object, int, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, 0, errors.New("input cannot be empty")
+	}
+
+	p := &parser{s: s}
+	srid, err := p.srid()
+	if err != nil {
+		return nil, 0, err
+	}
+	o, err := p.geometry()
+	if err != nil {
+		return nil, 0, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.s) {
+		return nil, 0, p.errorf("unexpected input %q", p.s[p.pos:])
+	}
+	return o, srid, nil
+}
+
+func (p *parser) srid() (int, error) {
+	p.skipSpace()
+	if !strings.HasPrefix(strings.ToUpper(p.s[p.pos:]), "SRID") {
+		return 0, nil
+	}
+	p.pos += len("SRID")
+	err := p.expect('=')
+	if err != nil {
+		return 0, err
+	}
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && (isDigit(p.s[p.pos]) || p.s[p.pos] == '-') {
+		p.pos++
+	}
+	srid, err := strconv.Atoi(p.s[start:p.pos])
+	if err != nil {
+		return 0, p.errorf("invalid SRID")
+	}
+	return srid, p.expect(';')
+}
+
+func (p *parser) geometry() (geometry.Object, error) {
+	tag := p.word()
+	if tag == "" {
+		return nil, p.errorf("expected a geometry type")
+	}
+
+	d := dims{}
+	for _, suffix := range []string{"ZM", "Z", "M"} {
+		if strings.HasSuffix(tag, suffix) && isGeometryTag(strings.TrimSuffix(tag, suffix)) {
+			tag = strings.TrimSuffix(tag, suffix)
+			d = dimsFromTag(suffix)
+			break
+		}
+	}
+	if !isGeometryTag(tag) {
+		return nil, p.errorf("unknown geometry type %q", tag)
+	}
+
+	empty := false
+	if w := p.peekWord(); w != "" {
+		p.word()
+		switch {
+		case !d.explicit && (w == "Z" || w == "M" || w == "ZM"):
+			d = dimsFromTag(w)
+			if p.peekWord() == "EMPTY" {
+				p.word()
+				empty = true
+			}
+		case w == "EMPTY":
+			empty = true
+		default:
+			return nil, p.errorf("unexpected %q", w)
+		}
+	}
+
+	switch tag {
+	case "POINT":
+		if empty {
+			return nil, p.errorf("empty points are not supported")
+		}
+		err := p.expect('(')
+		if err != nil {
+			return nil, err
+		}
+		pt, err := p.coordinate(&d)
+		if err != nil {
+			return nil, err
+		}
+		return &pt, p.expect(')')
+	case "LINESTRING":
+		if empty {
+			return &geometry.LineString{}, nil
+		}
+		points, err := p.points(&d)
+		if err != nil {
+			return nil, err
+		}
+		ln, err := geometry.NewLineString(points)
+		if err != nil {
+			return nil, p.errorf("%v", err)
+		}
+		return ln, nil
+	case "POLYGON":
+		if empty {
+			return &geometry.Polygon{}, nil
+		}
+		rings, err := p.rings(&d)
+		if err != nil {
+			return nil, err
+		}
+		poly, err := geometry.NewPolygon(rings)
+		if err != nil {
+			return nil, p.errorf("%v", err)
+		}
+		return poly, nil
+	case "MULTIPOINT":
+		if empty {
+			return &geometry.MultiPoint{}, nil
+		}
+		points, err := p.multiPoints(&d)
+		if err != nil {
+			return nil, err
+		}
+		return &geometry.MultiPoint{Coordinates: points}, nil
+	case "MULTILINESTRING":
+		if empty {
+			return &geometry.MultiLineString{}, nil
+		}
+		lines, err := p.rings(&d)
+		if err != nil {
+			return nil, err
+		}
+		return &geometry.MultiLineString{Coordinates: lines}, nil
+	case "MULTIPOLYGON":
+		if empty {
+			return &geometry.MultiPolygon{}, nil
+		}
+		polygons, err := p.polygons(&d)
+		if err != nil {
+			return nil, err
+		}
+		return &geometry.MultiPolygon{Coordinates: polygons}, nil
+	}
+
+	// GEOMETRYCOLLECTION
+	gc := &geometry.Collection{}
+	if empty {
+		return gc, nil
+	}
+	err := p.list(func() error {
+		o, err := p.geometry()
+		if err != nil {
+			return err
+		}
+		g, err := geometry.NewGeometry(o)
+		if err != nil {
+			return err
+		}
+		gc.Geometries = append(gc.Geometries, *g)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gc, nil
+}
+
+// list parses a parenthesized, comma separated list calling item for every element.
+func (p *parser) list(item func() error) error {
+	err := p.expect('(')
+	if err != nil {
+		return err
+	}
+	for {
+		err = item()
+		if err != nil {
+			return err
+		}
+		p.skipSpace()
+		if p.pos < len(p.s) && p.s[p.pos] == ',' {
+			p.pos++
+			continue
+		}
+		return p.expect(')')
+	}
+}
+
+func (p *parser) points(d *dims) ([]geometry.Point, error) {
+	var points []geometry.Point
+	err := p.list(func() error {
+		pt, err := p.coordinate(d)
+		points = append(points, pt)
+		return err
+	})
+	return points, err
+}
+
+// multiPoints parses the points of a MultiPoint which may be given with or without parentheses around every point.
+func (p *parser) multiPoints(d *dims) ([]geometry.Point, error) {
+	var points []geometry.Point
+	err := p.list(func() error {
+		p.skipSpace()
+		if p.pos < len(p.s) && p.s[p.pos] == '(' {
+			p.pos++
+			pt, err := p.coordinate(d)
+			if err != nil {
+				return err
+			}
+			points = append(points, pt)
+			return p.expect(')')
+		}
+		pt, err := p.coordinate(d)
+		points = append(points, pt)
+		return err
+	})
+	return points, err
+}
+
+func (p *parser) rings(d *dims) ([]geometry.LineString, error) {
+	var lines []geometry.LineString
+	err := p.list(func() error {
+		points, err := p.points(d)
+		lines = append(lines, geometry.LineString{Coordinates: points})
+		return err
+	})
+	return lines, err
+}
+
+func (p *parser) polygons(d *dims) ([]geometry.Polygon, error) {
+	var polygons []geometry.Polygon
+	err := p.list(func() error {
+		rings, err := p.rings(d)
+		if err != nil {
+			return err
+		}
+		poly, err := geometry.NewPolygon(rings)
+		if err != nil {
+			return p.errorf("%v", err)
+		}
+		polygons = append(polygons, *poly)
+		return nil
+	})
+	return polygons, err
+}
+
+// coordinate parses the ordinates of a single position. The first position of a geometry without a dimension tag
+// sets the dimensions of the rest of its positions.
+func (p *parser) coordinate(d *dims) (geometry.Point, error) {
+	var ordinates []float64
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.s) || !isNumberStart(p.s[p.pos]) {
+			break
+		}
+		v, err := p.number()
+		if err != nil {
+			return geometry.Point{}, err
+		}
+		ordinates = append(ordinates, v)
+	}
+
+	if !d.explicit {
+		switch len(ordinates) {
+		case 2:
+			*d = dims{explicit: true}
+		case 3:
+			*d = dims{z: true, explicit: true}
+		case 4:
+			*d = dims{z: true, m: true, explicit: true}
+		}
+	}
+
+	expected := 2
+	if d.z {
+		expected++
+	}
+	if d.m {
+		expected++
+	}
+	if len(ordinates) != expected {
+		return geometry.Point{}, p.errorf("expected %d ordinates, got %d", expected, len(ordinates))
+	}
+
+	pt := geometry.Point{Lng: ordinates[0], Lat: ordinates[1]}
+	i := 2
+	if d.z {
+		pt.Alt = &ordinates[i]
+		i++
+	}
+	if d.m {
+		pt.M = &ordinates[i]
+	}
+	return pt, nil
+}
+
+func (p *parser) number() (float64, error) {
+	start := p.pos
+	for p.pos < len(p.s) && isNumberChar(p.s[p.pos]) {
+		p.pos++
+	}
+	v, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+	if err != nil {
+		return 0, p.errorf("invalid number %q", p.s[start:p.pos])
+	}
+	return v, nil
+}
+
+// word reads the next word and returns it in upper case.
+func (p *parser) word() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && isLetter(p.s[p.pos]) {
+		p.pos++
+	}
+	return strings.ToUpper(p.s[start:p.pos])
+}
+
+func (p *parser) peekWord() string {
+	pos := p.pos
+	w := p.word()
+	p.pos = pos
+	return w
+}
+
+func (p *parser) expect(c byte) error {
+	p.skipSpace()
+	if p.pos >= len(p.s) || p.s[p.pos] != c {
+		return p.errorf("expected %q", c)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t' || p.s[p.pos] == '\n' || p.s[p.pos] == '\r') {
+		p.pos++
+	}
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("wkt: invalid input at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func dimsFromTag(tag string) dims {
+	return dims{z: strings.Contains(tag, "Z"), m: strings.Contains(tag, "M"), explicit: true}
+}
+
+func isGeometryTag(tag string) bool {
+	switch tag {
+	case "POINT", "LINESTRING", "POLYGON", "MULTIPOINT", "MULTILINESTRING", "MULTIPOLYGON", "GEOMETRYCOLLECTION":
+		return true
+	}
+	return false
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNumberStart(c byte) bool {
+	return isDigit(c) || c == '-' || c == '+' || c == '.'
+}
+
+func isNumberChar(c byte) bool {
+	return isNumberStart(c) || c == 'e' || c == 'E'
+}
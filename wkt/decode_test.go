@@ -0,0 +1,150 @@
+package wkt
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+func TestUnmarshal(t *testing.T) {
+	alt := 3.0
+	m := 4.0
+	tests := map[string]struct {
+		wkt     string
+		want    geometry.Object
+		wantErr bool
+	}{
+		"point": {
+			wkt:  "POINT(1 2)",
+			want: &geometry.Point{Lng: 1, Lat: 2},
+		},
+		"point lower case": {
+			wkt:  "  point ( -1.5 2e1 ) ",
+			want: &geometry.Point{Lng: -1.5, Lat: 20},
+		},
+		"point z": {
+			wkt:  "POINT Z (1 2 3)",
+			want: &geometry.Point{Lng: 1, Lat: 2, Alt: &alt},
+		},
+		"point z without tag": {
+			wkt:  "POINT(1 2 3)",
+			want: &geometry.Point{Lng: 1, Lat: 2, Alt: &alt},
+		},
+		"point m": {
+			wkt:  "POINTM(1 2 4)",
+			want: &geometry.Point{Lng: 1, Lat: 2, M: &m},
+		},
+		"point zm": {
+			wkt:  "POINT ZM (1 2 3 4)",
+			want: &geometry.Point{Lng: 1, Lat: 2, Alt: &alt, M: &m},
+		},
+		"lineString": {
+			wkt:  "LINESTRING(30 10, 10 30, 40 40)",
+			want: &geometry.LineString{Coordinates: []geometry.Point{{Lng: 30, Lat: 10}, {Lng: 10, Lat: 30}, {Lng: 40, Lat: 40}}},
+		},
+		"empty lineString": {
+			wkt:  "LINESTRING EMPTY",
+			want: &geometry.LineString{},
+		},
+		"polygon": {
+			wkt: "POLYGON((30 10, 40 40, 20 40, 30 10))",
+			want: &geometry.Polygon{Coordinates: []geometry.LineString{
+				{Coordinates: []geometry.Point{{Lng: 30, Lat: 10}, {Lng: 40, Lat: 40}, {Lng: 20, Lat: 40}, {Lng: 30, Lat: 10}}},
+			}},
+		},
+		"multiPoint": {
+			wkt:  "MULTIPOINT((10 40), (40 30))",
+			want: &geometry.MultiPoint{Coordinates: []geometry.Point{{Lng: 10, Lat: 40}, {Lng: 40, Lat: 30}}},
+		},
+		"multiPoint without parentheses": {
+			wkt:  "MULTIPOINT(10 40, 40 30)",
+			want: &geometry.MultiPoint{Coordinates: []geometry.Point{{Lng: 10, Lat: 40}, {Lng: 40, Lat: 30}}},
+		},
+		"multiLineString": {
+			wkt: "MULTILINESTRING((10 10, 20 20),(40 40, 30 30))",
+			want: &geometry.MultiLineString{Coordinates: []geometry.LineString{
+				{Coordinates: []geometry.Point{{Lng: 10, Lat: 10}, {Lng: 20, Lat: 20}}},
+				{Coordinates: []geometry.Point{{Lng: 40, Lat: 40}, {Lng: 30, Lat: 30}}},
+			}},
+		},
+		"multiPolygon": {
+			wkt: "MULTIPOLYGON(((30 20, 45 40, 10 40, 30 20)))",
+			want: &geometry.MultiPolygon{Coordinates: []geometry.Polygon{
+				{Coordinates: []geometry.LineString{
+					{Coordinates: []geometry.Point{{Lng: 30, Lat: 20}, {Lng: 45, Lat: 40}, {Lng: 10, Lat: 40}, {Lng: 30, Lat: 20}}},
+				}},
+			}},
+		},
+		"geometryCollection": {
+			wkt: "GEOMETRYCOLLECTION(POINT(4 6),LINESTRING(4 6,7 10))",
+			want: &geometry.Collection{Geometries: []geometry.Geometry{
+				{GeoJSONType: geojson.Point, Coordinates: []float64{4, 6}},
+				{GeoJSONType: geojson.LineString, Coordinates: [][]float64{{4, 6}, {7, 10}}},
+			}},
+		},
+		"empty geometryCollection": {
+			wkt:  "GEOMETRYCOLLECTION EMPTY",
+			want: &geometry.Collection{},
+		},
+		"empty point": {
+			wkt:     "POINT EMPTY",
+			wantErr: true,
+		},
+		"unknown type": {
+			wkt:     "CIRCLE(1 2)",
+			wantErr: true,
+		},
+		"mixed dimensions": {
+			wkt:     "LINESTRING(1 2, 3 4 5)",
+			wantErr: true,
+		},
+		"unclosed ring": {
+			wkt:     "POLYGON((30 10, 40 40, 20 40, 30 11))",
+			wantErr: true,
+		},
+		"trailing input": {
+			wkt:     "POINT(1 2) POINT(3 4)",
+			wantErr: true,
+		},
+		"empty input": {
+			wkt:     "",
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Unmarshal(tt.wkt)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unmarshal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalEWKT(t *testing.T) {
+	o, srid, err := UnmarshalEWKT("SRID=4326;POINT(23.44 35.55)")
+	if err != nil {
+		t.Errorf("UnmarshalEWKT error %v", err)
+	}
+	assert.Equal(t, srid, 4326)
+	assert.Equal(t, o.(*geometry.Point).Lat, 35.55)
+	assert.Equal(t, o.(*geometry.Point).Lng, 23.44)
+
+	_, srid, err = UnmarshalEWKT("POINT(23.44 35.55)")
+	if err != nil {
+		t.Errorf("UnmarshalEWKT error %v", err)
+	}
+	assert.Equal(t, srid, 0)
+
+	_, _, err = UnmarshalEWKT("SRID=abc;POINT(23.44 35.55)")
+	if err == nil {
+		t.Errorf("UnmarshalEWKT expected an error for an invalid SRID")
+	}
+}
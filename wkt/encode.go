@@ -0,0 +1,229 @@
+package wkt
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+type encoder struct {
+	b         strings.Builder
+	precision int
+}
+
+// Marshal returns the WKT representation of the geometry.
+// The ordinates are written with at most precision decimal digits, a negative precision writes the shortest
+// representation which parses back to the same value.
+func Marshal(o geometry.Object, precision int) (string, error) {
+	e := &encoder{precision: precision}
+	err := e.write(o)
+	if err != nil {
+		return "", err
+	}
+	return e.b.String(), nil
+}
+
+// MarshalEWKT returns the EWKT representation of the geometry which is its WKT prefixed with the SRID.
+func MarshalEWKT(o geometry.Object, srid int, precision int) (string, error) {
+	s, err := Marshal(o, precision)
+	if err != nil {
+		return "", err
+	}
+	return "SRID=" + strconv.Itoa(srid) + ";" + s, nil
+}
+
+func (e *encoder) write(o geometry.Object) error {
+	switch gtp := o.(type) {
+	case *geometry.Point:
+		return e.write(*gtp)
+	case *geometry.MultiPoint:
+		return e.write(*gtp)
+	case *geometry.LineString:
+		return e.write(*gtp)
+	case *geometry.MultiLineString:
+		return e.write(*gtp)
+	case *geometry.Polygon:
+		return e.write(*gtp)
+	case *geometry.MultiPolygon:
+		return e.write(*gtp)
+	case *geometry.Collection:
+		return e.write(*gtp)
+	case geometry.Point:
+		d := dimsOf([]geometry.Point{gtp})
+		e.tag("POINT", d)
+		e.b.WriteByte('(')
+		e.coordinate(gtp, d)
+		e.b.WriteByte(')')
+	case geometry.MultiPoint:
+		d := dimsOf(gtp.Coordinates)
+		e.tag("MULTIPOINT", d)
+		if len(gtp.Coordinates) == 0 {
+			e.b.WriteString(" EMPTY")
+			return nil
+		}
+		e.b.WriteByte('(')
+		for i, p := range gtp.Coordinates {
+			if i > 0 {
+				e.b.WriteByte(',')
+			}
+			e.b.WriteByte('(')
+			e.coordinate(p, d)
+			e.b.WriteByte(')')
+		}
+		e.b.WriteByte(')')
+	case geometry.LineString:
+		d := dimsOf(gtp.Coordinates)
+		e.tag("LINESTRING", d)
+		if len(gtp.Coordinates) == 0 {
+			e.b.WriteString(" EMPTY")
+			return nil
+		}
+		e.points(gtp.Coordinates, d)
+	case geometry.MultiLineString:
+		d := dimsOf(linePoints(gtp.Coordinates))
+		e.tag("MULTILINESTRING", d)
+		if len(gtp.Coordinates) == 0 {
+			e.b.WriteString(" EMPTY")
+			return nil
+		}
+		e.lines(gtp.Coordinates, d)
+	case geometry.Polygon:
+		d := dimsOf(linePoints(gtp.Coordinates))
+		e.tag("POLYGON", d)
+		if len(gtp.Coordinates) == 0 {
+			e.b.WriteString(" EMPTY")
+			return nil
+		}
+		e.lines(gtp.Coordinates, d)
+	case geometry.MultiPolygon:
+		var points []geometry.Point
+		for _, p := range gtp.Coordinates {
+			points = append(points, linePoints(p.Coordinates)...)
+		}
+		d := dimsOf(points)
+		e.tag("MULTIPOLYGON", d)
+		if len(gtp.Coordinates) == 0 {
+			e.b.WriteString(" EMPTY")
+			return nil
+		}
+		e.b.WriteByte('(')
+		for i, p := range gtp.Coordinates {
+			if i > 0 {
+				e.b.WriteByte(',')
+			}
+			e.lines(p.Coordinates, d)
+		}
+		e.b.WriteByte(')')
+	case geometry.Collection:
+		objects, err := gtp.Objects()
+		if err != nil {
+			return err
+		}
+		e.b.WriteString("GEOMETRYCOLLECTION")
+		if len(objects) == 0 {
+			e.b.WriteString(" EMPTY")
+			return nil
+		}
+		e.b.WriteByte('(')
+		for i, o := range objects {
+			if i > 0 {
+				e.b.WriteByte(',')
+			}
+			err = e.write(o)
+			if err != nil {
+				return err
+			}
+		}
+		e.b.WriteByte(')')
+	default:
+		return errors.New("unsupported geometry type")
+	}
+	return nil
+}
+
+func (e *encoder) tag(name string, d dims) {
+	e.b.WriteString(name)
+	switch {
+	case d.z && d.m:
+		e.b.WriteString(" ZM ")
+	case d.z:
+		e.b.WriteString(" Z ")
+	case d.m:
+		e.b.WriteString(" M ")
+	}
+}
+
+func (e *encoder) lines(lines []geometry.LineString, d dims) {
+	e.b.WriteByte('(')
+	for i, l := range lines {
+		if i > 0 {
+			e.b.WriteByte(',')
+		}
+		e.points(l.Coordinates, d)
+	}
+	e.b.WriteByte(')')
+}
+
+func (e *encoder) points(points []geometry.Point, d dims) {
+	e.b.WriteByte('(')
+	for i, p := range points {
+		if i > 0 {
+			e.b.WriteByte(',')
+		}
+		e.coordinate(p, d)
+	}
+	e.b.WriteByte(')')
+}
+
+// coordinate writes the ordinates of the point, a missing altitude or measure is written as 0.
+func (e *encoder) coordinate(p geometry.Point, d dims) {
+	e.number(p.Lng)
+	e.b.WriteByte(' ')
+	e.number(p.Lat)
+	if d.z {
+		e.b.WriteByte(' ')
+		e.number(valueOrZero(p.Alt))
+	}
+	if d.m {
+		e.b.WriteByte(' ')
+		e.number(valueOrZero(p.M))
+	}
+}
+
+func (e *encoder) number(v float64) {
+	s := strconv.FormatFloat(v, 'f', e.precision, 64)
+	if e.precision > 0 {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		s = "0"
+	}
+	e.b.WriteString(s)
+}
+
+// dimsOf returns the dimensions of a list of points, a dimension is present if any of the points has it.
+func dimsOf(points []geometry.Point) dims {
+	d := dims{explicit: true}
+	for _, p := range points {
+		d.z = d.z || p.Alt != nil
+		d.m = d.m || p.M != nil
+	}
+	return d
+}
+
+func linePoints(lines []geometry.LineString) []geometry.Point {
+	var points []geometry.Point
+	for _, l := range lines {
+		points = append(points, l.Coordinates...)
+	}
+	return points
+}
+
+func valueOrZero(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
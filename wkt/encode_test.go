@@ -0,0 +1,115 @@
+package wkt
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/geojson/geometry"
+)
+
+func TestMarshal(t *testing.T) {
+	alt := 3.0
+	m := 4.0
+	tests := map[string]struct {
+		object    geometry.Object
+		precision int
+		want      string
+	}{
+		"point": {
+			object:    geometry.Point{Lng: 1, Lat: 2},
+			precision: -1,
+			want:      "POINT(1 2)",
+		},
+		"point pointer": {
+			object:    &geometry.Point{Lng: 1.123456, Lat: -0.0000001},
+			precision: 3,
+			want:      "POINT(1.123 0)",
+		},
+		"point z": {
+			object:    geometry.Point{Lng: 1, Lat: 2, Alt: &alt},
+			precision: -1,
+			want:      "POINT Z (1 2 3)",
+		},
+		"point m": {
+			object:    geometry.Point{Lng: 1, Lat: 2, M: &m},
+			precision: -1,
+			want:      "POINT M (1 2 4)",
+		},
+		"point zm": {
+			object:    geometry.Point{Lng: 1, Lat: 2, Alt: &alt, M: &m},
+			precision: -1,
+			want:      "POINT ZM (1 2 3 4)",
+		},
+		"lineString": {
+			object:    geometry.LineString{Coordinates: []geometry.Point{{Lng: 30.25, Lat: 10}, {Lng: 10, Lat: 30.5}}},
+			precision: 6,
+			want:      "LINESTRING(30.25 10,10 30.5)",
+		},
+		"lineString with missing altitude": {
+			object:    geometry.LineString{Coordinates: []geometry.Point{{Lng: 30, Lat: 10, Alt: &alt}, {Lng: 10, Lat: 30}}},
+			precision: -1,
+			want:      "LINESTRING Z (30 10 3,10 30 0)",
+		},
+		"empty lineString": {
+			object:    geometry.LineString{},
+			precision: -1,
+			want:      "LINESTRING EMPTY",
+		},
+		"multiPoint": {
+			object:    geometry.MultiPoint{Coordinates: []geometry.Point{{Lng: 10, Lat: 40}, {Lng: 40, Lat: 30}}},
+			precision: -1,
+			want:      "MULTIPOINT((10 40),(40 30))",
+		},
+		"multiPolygon": {
+			object: geometry.MultiPolygon{Coordinates: []geometry.Polygon{
+				{Coordinates: []geometry.LineString{
+					{Coordinates: []geometry.Point{{Lng: 30, Lat: 20}, {Lng: 45, Lat: 40}, {Lng: 10, Lat: 40}, {Lng: 30, Lat: 20}}},
+				}},
+			}},
+			precision: -1,
+			want:      "MULTIPOLYGON(((30 20,45 40,10 40,30 20)))",
+		},
+		"empty geometryCollection": {
+			object:    geometry.Collection{},
+			precision: -1,
+			want:      "GEOMETRYCOLLECTION EMPTY",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Marshal(tt.object, tt.precision)
+			if err != nil {
+				t.Errorf("Marshal error %v", err)
+			}
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	inputs := []string{
+		"POLYGON((35 10,45 45,15 40,10 20,35 10),(20 30,35 35,30 20,20 30))",
+		"MULTILINESTRING Z ((10 10 1,20 20 2),(40 40 3,30 30 4))",
+		"GEOMETRYCOLLECTION(POINT(4 6),LINESTRING(4 6,7 10),POLYGON((30 10,40 40,20 40,30 10)))",
+	}
+	for _, in := range inputs {
+		o, err := Unmarshal(in)
+		if err != nil {
+			t.Errorf("Unmarshal error %v", err)
+			continue
+		}
+		got, err := Marshal(o, -1)
+		if err != nil {
+			t.Errorf("Marshal error %v", err)
+		}
+		assert.Equal(t, got, in)
+	}
+}
+
+func TestMarshalEWKT(t *testing.T) {
+	got, err := MarshalEWKT(geometry.Point{Lng: 23.44, Lat: 35.55}, 4326, -1)
+	if err != nil {
+		t.Errorf("MarshalEWKT error %v", err)
+	}
+	assert.Equal(t, got, "SRID=4326;POINT(23.44 35.55)")
+}